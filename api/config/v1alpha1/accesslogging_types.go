@@ -5,6 +5,11 @@
 
 package v1alpha1
 
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
 type ProxyAccessLog struct {
 	// Disable disables access logging for managed proxies if set to true.
 	Disable bool `json:"disable,omitempty"`
@@ -17,6 +22,10 @@ type ProxyAccessLog struct {
 type ProxyAccessLogSetting struct {
 	// Format defines the format of accesslog.
 	Format ProxyAccessLogFormat `json:"format"`
+	// Filter defines the filter of accesslog. Only the requests matching
+	// the filter are logged. If unspecified, all the requests are logged.
+	// +optional
+	Filter *ProxyAccessLogFilter `json:"filter,omitempty"`
 	// Sinks defines the sinks of accesslog.
 	// +kubebuilder:validation:MinItems=1
 	Sinks []ProxyAccessLogSink `json:"sinks"`
@@ -54,6 +63,90 @@ type ProxyAccessLogFormat struct {
 	JSON map[string]string `json:"json,omitempty"`
 }
 
+// ProxyAccessLogFilterOperator defines how the conditions of an accesslog filter are combined.
+// +kubebuilder:validation:Enum=And;Or
+type ProxyAccessLogFilterOperator string
+
+const (
+	// ProxyAccessLogFilterOperatorAnd logs the requests matching all the conditions.
+	ProxyAccessLogFilterOperatorAnd ProxyAccessLogFilterOperator = "And"
+	// ProxyAccessLogFilterOperatorOr logs the requests matching any of the conditions.
+	ProxyAccessLogFilterOperatorOr ProxyAccessLogFilterOperator = "Or"
+)
+
+// ProxyAccessLogFilter defines the filter of accesslog.
+// The StatusCode and Header conditions never match the entries of the TCP
+// connections and UDP sessions, so these entries are not logged if the
+// conditions are ANDed together, and only match the other conditions if
+// they are ORed together.
+type ProxyAccessLogFilter struct {
+	// Operator defines how the conditions are combined.
+	// Defaults to "And".
+	// +optional
+	Operator *ProxyAccessLogFilterOperator `json:"operator,omitempty"`
+	// Conditions defines the conditions of the filter.
+	// +kubebuilder:validation:MinItems=1
+	Conditions []ProxyAccessLogFilterCondition `json:"conditions"`
+}
+
+// ProxyAccessLogFilterCondition defines a condition of an accesslog filter.
+// Exactly one of the fields must be set.
+type ProxyAccessLogFilterCondition struct {
+	// StatusCode matches the requests whose response status code is in the range.
+	// It only matches the access logs of the HTTP requests.
+	// +optional
+	StatusCode *AccessLogStatusCodeRange `json:"statusCode,omitempty"`
+	// MinDuration matches the requests whose total duration is greater than
+	// or equal to the duration, e.g. "500ms".
+	// +optional
+	MinDuration *metav1.Duration `json:"minDuration,omitempty"`
+	// ResponseFlags matches the requests with any of the Envoy response flags, e.g. "UH" or "UF".
+	// See the [response flags](https://www.envoyproxy.io/docs/envoy/latest/configuration/observability/access_log/usage#config-access-log-format-response-flags).
+	// +optional
+	ResponseFlags []AccessLogResponseFlag `json:"responseFlags,omitempty"`
+	// Header matches the requests with the header. If the value is unspecified,
+	// the header must be present with any value. It only matches the access
+	// logs of the HTTP requests.
+	// +optional
+	Header *AccessLogHeaderMatch `json:"header,omitempty"`
+	// SamplePercent matches a random sample of the requests, as a percentage.
+	// The sample is based on the x-request-id header, so the same requests
+	// are sampled by all the accesslogs.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	SamplePercent *uint32 `json:"samplePercent,omitempty"`
+}
+
+// AccessLogStatusCodeRange defines an inclusive range of response status codes.
+// At least one of min and max must be set.
+type AccessLogStatusCodeRange struct {
+	// Min is the minimum status code of the range.
+	// +optional
+	// +kubebuilder:validation:Minimum=100
+	// +kubebuilder:validation:Maximum=599
+	Min *uint32 `json:"min,omitempty"`
+	// Max is the maximum status code of the range.
+	// +optional
+	// +kubebuilder:validation:Minimum=100
+	// +kubebuilder:validation:Maximum=599
+	Max *uint32 `json:"max,omitempty"`
+}
+
+// AccessLogHeaderMatch defines a request header matched by an accesslog filter.
+type AccessLogHeaderMatch struct {
+	// Name is the name of the header.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Value is the exact value of the header.
+	// +optional
+	Value *string `json:"value,omitempty"`
+}
+
+// AccessLogResponseFlag is an Envoy response flag.
+// +kubebuilder:validation:Enum=LH;UH;UT;LR;UR;UF;UC;UO;NR;DI;FI;RL;UAEX;RLSE;DC;URX;SI;IH;DPE;UMSDR;RFCF;NFCF;DT;UPE;NC;OM
+type AccessLogResponseFlag string
+
 type ProxyAccessLogSinkType string
 
 const (
@@ -63,11 +156,24 @@ const (
 	// When the provider is Kubernetes, EnvoyGateway always sends `k8s.namespace.name`
 	// and `k8s.pod.name` as additional attributes.
 	ProxyAccessLogSinkTypeOpenTelemetry ProxyAccessLogSinkType = "OpenTelemetry"
+	// ProxyAccessLogSinkTypeALS defines the gRPC Access Log Service (ALS) sink.
+	// The access logs are sent to a gRPC service implementing the Envoy
+	// [AccessLogService](https://www.envoyproxy.io/docs/envoy/latest/api-v3/service/accesslog/v3/als.proto).
+	ProxyAccessLogSinkTypeALS ProxyAccessLogSinkType = "ALS"
+	// ProxyAccessLogSinkTypeStdout defines the standard output accesslog sink.
+	// The access logs are written by the stdout logger of Envoy, in the format
+	// of the setting, e.g. one JSON object per line for the JSON format.
+	ProxyAccessLogSinkTypeStdout ProxyAccessLogSinkType = "Stdout"
+	// ProxyAccessLogSinkTypeStderr defines the standard error accesslog sink.
+	// The access logs are written by the stderr logger of Envoy, in the format
+	// of the setting.
+	ProxyAccessLogSinkTypeStderr ProxyAccessLogSinkType = "Stderr"
 )
 
 type ProxyAccessLogSink struct {
 	// Type defines the type of accesslog sink.
-	// +kubebuilder:validation:Enum=File;OpenTelemetry
+	// The Stdout and Stderr sinks have no settings.
+	// +kubebuilder:validation:Enum=File;OpenTelemetry;ALS;Stdout;Stderr
 	Type ProxyAccessLogSinkType `json:"type,omitempty"`
 	// File defines the file accesslog sink.
 	// +optional
@@ -75,6 +181,9 @@ type ProxyAccessLogSink struct {
 	// OpenTelemetry defines the OpenTelemetry accesslog sink.
 	// +optional
 	OpenTelemetry *OpenTelemetryEnvoyProxyAccessLog `json:"openTelemetry,omitempty"`
+	// ALS defines the gRPC Access Log Service (ALS) sink.
+	// +optional
+	ALS *ALSEnvoyProxyAccessLog `json:"als,omitempty"`
 }
 
 type FileEnvoyProxyAccessLog struct {
@@ -100,3 +209,60 @@ type OpenTelemetryEnvoyProxyAccessLog struct {
 
 	// TODO: support more OpenTelemetry accesslog options(e.g. TLS, auth etc.) in the future.
 }
+
+// ALSEnvoyProxyAccessLogType defines the type of the access log entries sent to an ALS.
+// +kubebuilder:validation:Enum=HTTP;TCP
+type ALSEnvoyProxyAccessLogType string
+
+const (
+	// ALSEnvoyProxyAccessLogTypeHTTP sends HTTP access log entries, with the
+	// details of the requests and the responses.
+	ALSEnvoyProxyAccessLogTypeHTTP ALSEnvoyProxyAccessLogType = "HTTP"
+	// ALSEnvoyProxyAccessLogTypeTCP sends TCP access log entries, with the
+	// details of the connections.
+	ALSEnvoyProxyAccessLogTypeTCP ALSEnvoyProxyAccessLogType = "TCP"
+)
+
+// ALSEnvoyProxyAccessLog defines the gRPC Access Log Service (ALS) sink.
+// The format of the access log setting is ignored, the entries are sent in
+// the structured format of the service.
+type ALSEnvoyProxyAccessLog struct {
+	// BackendRef references the Kubernetes Service of the ALS.
+	// The namespace defaults to the namespace of the EnvoyProxy.
+	BackendRef gwapiv1b1.BackendObjectReference `json:"backendRef"`
+	// LogName defines the friendly name of the access log, sent to the service
+	// to distinguish the access logs. Defaults to "envoy-gateway".
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	LogName *string `json:"logName,omitempty"`
+	// Type defines the type of the access log entries.
+	// Defaults to "HTTP".
+	// +optional
+	Type *ALSEnvoyProxyAccessLogType `json:"type,omitempty"`
+	// BufferFlushInterval defines the interval to flush the buffer of the
+	// access log entries to the service. Defaults to 1s.
+	// +optional
+	BufferFlushInterval *metav1.Duration `json:"bufferFlushInterval,omitempty"`
+	// BufferSizeBytes defines the size of the buffer of the access log entries,
+	// which is flushed when it's full. Defaults to 16KB.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	BufferSizeBytes *uint32 `json:"bufferSizeBytes,omitempty"`
+	// HTTP defines the additional settings of the HTTP access log entries.
+	// +optional
+	HTTP *ALSEnvoyProxyHTTPAccessLogConfig `json:"http,omitempty"`
+}
+
+// ALSEnvoyProxyHTTPAccessLogConfig defines the additional settings of the HTTP
+// access log entries sent to an ALS.
+type ALSEnvoyProxyHTTPAccessLogConfig struct {
+	// RequestHeaders defines the additional request headers to log.
+	// +optional
+	RequestHeaders []string `json:"requestHeaders,omitempty"`
+	// ResponseHeaders defines the additional response headers to log.
+	// +optional
+	ResponseHeaders []string `json:"responseHeaders,omitempty"`
+	// ResponseTrailers defines the additional response trailers to log.
+	// +optional
+	ResponseTrailers []string `json:"responseTrailers,omitempty"`
+}
@@ -316,6 +316,7 @@ type RateLimit struct {
 type RateLimitDatabaseBackend struct {
 	// Type is the type of database backend to use. Supported types are:
 	//	* Redis: Connects to a Redis database.
+	//	* Memcached: Connects to Memcached servers.
 	//
 	// +unionDiscriminator
 	Type RateLimitDatabaseBackendType `json:"type"`
@@ -323,16 +324,22 @@ type RateLimitDatabaseBackend struct {
 	//
 	// +optional
 	Redis *RateLimitRedisSettings `json:"redis,omitempty"`
+	// Memcached defines the settings needed to connect to Memcached servers.
+	//
+	// +optional
+	Memcached *RateLimitMemcachedSettings `json:"memcached,omitempty"`
 }
 
 // RateLimitDatabaseBackendType specifies the types of database backend
 // to be used by the rate limit service.
-// +kubebuilder:validation:Enum=Redis
+// +kubebuilder:validation:Enum=Redis;Memcached
 type RateLimitDatabaseBackendType string
 
 const (
 	// RedisBackendType uses a redis database for the rate limit service.
 	RedisBackendType RateLimitDatabaseBackendType = "Redis"
+	// MemcachedBackendType uses memcached servers for the rate limit service.
+	MemcachedBackendType RateLimitDatabaseBackendType = "Memcached"
 )
 
 // RedisTLSSettings defines the TLS configuration for connecting to redis database.
@@ -343,10 +350,48 @@ type RedisTLSSettings struct {
 	CertificateRef *gwapiv1b1.SecretObjectReference `json:"certificateRef,omitempty"`
 }
 
+// RedisType specifies the topology of the redis deployment.
+// +kubebuilder:validation:Enum=Single;Sentinel;Cluster
+type RedisType string
+
+const (
+	// RedisTypeSingle connects to a single redis server.
+	RedisTypeSingle RedisType = "Single"
+	// RedisTypeSentinel connects to the master of a redis deployment
+	// monitored by redis sentinels.
+	RedisTypeSentinel RedisType = "Sentinel"
+	// RedisTypeCluster connects to the nodes of a redis cluster.
+	RedisTypeCluster RedisType = "Cluster"
+)
+
 // RateLimitRedisSettings defines the configuration for connecting to redis database.
 type RateLimitRedisSettings struct {
-	// URL of the Redis Database.
-	URL string `json:"url"`
+	// Type is the topology of the redis deployment. Defaults to Single.
+	//
+	// +optional
+	Type *RedisType `json:"type,omitempty"`
+
+	// URL of the Redis Database. Required for the Single type.
+	//
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// Sentinel defines the redis sentinels to connect to. Required for the
+	// Sentinel type.
+	//
+	// +optional
+	Sentinel *RedisSentinelSettings `json:"sentinel,omitempty"`
+
+	// Cluster defines the nodes of the redis cluster to connect to. Required
+	// for the Cluster type.
+	//
+	// +optional
+	Cluster *RedisClusterSettings `json:"cluster,omitempty"`
+
+	// Auth defines the credentials for connecting to redis database.
+	//
+	// +optional
+	Auth *RedisAuth `json:"auth,omitempty"`
 
 	// TLS defines TLS configuration for connecting to redis database.
 	//
@@ -354,6 +399,48 @@ type RateLimitRedisSettings struct {
 	TLS *RedisTLSSettings `json:"tls,omitempty"`
 }
 
+// RedisSentinelSettings defines the configuration for connecting to the master
+// of a redis deployment through redis sentinels.
+type RedisSentinelSettings struct {
+	// MasterName is the name of the master monitored by the sentinels.
+	MasterName string `json:"masterName"`
+
+	// URLs are the host:port addresses of the sentinels.
+	//
+	// +kubebuilder:validation:MinItems=1
+	URLs []string `json:"urls"`
+}
+
+// RedisClusterSettings defines the configuration for connecting to a redis cluster.
+type RedisClusterSettings struct {
+	// URLs are the host:port addresses of the nodes of the cluster, used to
+	// discover the topology of the cluster.
+	//
+	// +kubebuilder:validation:MinItems=1
+	URLs []string `json:"urls"`
+}
+
+// RedisAuth defines the credentials for connecting to redis database.
+type RedisAuth struct {
+	// Username is the name of the redis user. If unspecified, the password
+	// authenticates the default user.
+	//
+	// +optional
+	Username *string `json:"username,omitempty"`
+
+	// PasswordRef references the Kubernetes Secret holding the password of the
+	// user in its "password" key.
+	PasswordRef gwapiv1b1.SecretObjectReference `json:"passwordRef"`
+}
+
+// RateLimitMemcachedSettings defines the configuration for connecting to memcached servers.
+type RateLimitMemcachedSettings struct {
+	// URLs are the host:port addresses of the memcached servers.
+	//
+	// +kubebuilder:validation:MinItems=1
+	URLs []string `json:"urls"`
+}
+
 // ExtensionManager defines the configuration for registering an extension manager to
 // the Envoy Gateway control plane.
 type ExtensionManager struct {
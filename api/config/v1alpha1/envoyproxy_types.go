@@ -6,6 +6,7 @@
 package v1alpha1
 
 import (
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -63,8 +64,97 @@ type EnvoyProxySpec struct {
 	//
 	// +optional
 	Concurrency *int32 `json:"concurrency,omitempty"`
+
+	// RoutingType defines how the managed proxies route the requests to the
+	// Service backends. If unspecified, the requests are routed to the ready
+	// endpoints of the Service EndpointSlices.
+	//
+	// +optional
+	RoutingType *RoutingType `json:"routingType,omitempty"`
+
+	// Overload defines the protections of the managed proxies against
+	// overload, such as the maximum number of connections of the listeners
+	// and the overload manager actions triggered by the heap size of Envoy.
+	//
+	// +optional
+	Overload *ProxyOverload `json:"overload,omitempty"`
+}
+
+// ProxyOverload defines the protections of the managed proxies against overload.
+type ProxyOverload struct {
+	// MaxListenerConnections is the maximum number of open downstream
+	// connections of each filter chain of the listeners. The new connections
+	// over the limit are closed. The limit is not shared by the filter chains
+	// of a listener, e.g. an HTTPS listener with a filter chain for each of its
+	// N hostnames accepts up to N times MaxListenerConnections connections.
+	// If unspecified, the connections are not limited.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxListenerConnections *uint64 `json:"maxListenerConnections,omitempty"`
+
+	// MaxHeapSize is the maximum heap size of Envoy, which the thresholds
+	// of the actions are relative to. It should be lower than the memory
+	// limit of the Envoy container. Required when actions are set.
+	//
+	// +optional
+	MaxHeapSize *resource.Quantity `json:"maxHeapSize,omitempty"`
+
+	// Actions are the overload manager actions triggered when the heap
+	// size of Envoy reaches their thresholds.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=4
+	Actions []ProxyOverloadAction `json:"actions,omitempty"`
 }
 
+// ProxyOverloadAction defines an action of the overload manager of Envoy.
+type ProxyOverloadAction struct {
+	// Type is the type of the action.
+	Type ProxyOverloadActionType `json:"type"`
+
+	// HeapThresholdPercent is the percentage of the maximum heap size
+	// at which the action is triggered.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	HeapThresholdPercent uint32 `json:"heapThresholdPercent"`
+}
+
+// ProxyOverloadActionType defines the types of the overload manager actions.
+// +kubebuilder:validation:Enum=StopAcceptingConnections;StopAcceptingRequests;DisableHTTPKeepAlive;ShrinkHeap
+type ProxyOverloadActionType string
+
+const (
+	// StopAcceptingConnectionsOverloadAction stops accepting new connections on the listeners.
+	StopAcceptingConnectionsOverloadAction ProxyOverloadActionType = "StopAcceptingConnections"
+
+	// StopAcceptingRequestsOverloadAction rejects new requests with a 503 status code.
+	StopAcceptingRequestsOverloadAction ProxyOverloadActionType = "StopAcceptingRequests"
+
+	// DisableHTTPKeepAliveOverloadAction closes the downstream HTTP connections
+	// after their current requests, to drain the connections.
+	DisableHTTPKeepAliveOverloadAction ProxyOverloadActionType = "DisableHTTPKeepAlive"
+
+	// ShrinkHeapOverloadAction periodically releases the free memory of the heap
+	// to the system.
+	ShrinkHeapOverloadAction ProxyOverloadActionType = "ShrinkHeap"
+)
+
+// RoutingType defines the type of routing to the Service backends.
+// +kubebuilder:validation:Enum=Endpoint;Service
+type RoutingType string
+
+const (
+	// EndpointRoutingType routes the requests to the endpoints of the Service,
+	// so that Envoy load balances across the pods.
+	EndpointRoutingType RoutingType = "Endpoint"
+
+	// ServiceRoutingType routes the requests to the Cluster IP of the Service,
+	// and leaves the load balancing across the pods to kube-proxy.
+	ServiceRoutingType RoutingType = "Service"
+)
+
 type ProxyTelemetry struct {
 	// AccessLogs defines accesslog parameters for managed proxies.
 	// If unspecified, will send default format to stdout.
@@ -113,6 +203,17 @@ type EnvoyProxyKubernetesProvider struct {
 	//
 	// +optional
 	EnvoyService *KubernetesServiceSpec `json:"envoyService,omitempty"`
+
+	// Zone is the topology zone of the Envoy proxies, which Envoy compares with
+	// the zones of the backend endpoints for zone aware routing. It should only
+	// be set when all the Envoy pods are scheduled in this zone, e.g. with the
+	// affinity of the Envoy deployment. If unspecified, the zone is read from the
+	// topology.kubernetes.io/zone label of the Envoy pods. Kubernetes only sets
+	// this label on the nodes, so it must be copied to the pods, e.g. by an
+	// admission webhook, for zone aware routing to apply.
+	//
+	// +optional
+	Zone *string `json:"zone,omitempty"`
 }
 
 // ProxyLogging defines logging parameters for managed proxies.
@@ -18,30 +18,59 @@ type ProxyTracing struct {
 	// If provider is kubernetes, pod name and namespace are added by default.
 	CustomTags map[string]CustomTag `json:"customTags,omitempty"`
 	// Provider defines the tracing provider.
-	// Only OpenTelemetry is supported currently.
 	Provider TracingProvider `json:"provider"`
 }
 
 type TracingProviderType string
 
 const (
+	// TracingProviderTypeOpenTelemetry sends the traces to an OpenTelemetry
+	// collector over OTLP/gRPC, with the W3C trace context propagation.
 	TracingProviderTypeOpenTelemetry TracingProviderType = "OpenTelemetry"
+	// TracingProviderTypeZipkin sends the traces to a Zipkin collector over
+	// HTTP, with the B3 propagation.
+	TracingProviderTypeZipkin TracingProviderType = "Zipkin"
+	// TracingProviderTypeDatadog sends the traces to a Datadog agent over
+	// HTTP, with the Datadog propagation.
+	TracingProviderTypeDatadog TracingProviderType = "Datadog"
 )
 
 type TracingProvider struct {
 	// Type defines the tracing provider type.
-	// EG currently only supports OpenTelemetry.
-	// +kubebuilder:validation:Enum=OpenTelemetry
+	// +kubebuilder:validation:Enum=OpenTelemetry;Zipkin;Datadog
 	// +kubebuilder:default=OpenTelemetry
 	Type TracingProviderType `json:"type"`
 	// Host define the provider service hostname.
 	Host string `json:"host"`
 	// Port defines the port the provider service is exposed on.
+	// Defaults to 4317 for OpenTelemetry, 9411 for Zipkin and 8126 for Datadog.
 	//
 	// +optional
 	// +kubebuilder:validation:Minimum=0
-	// +kubebuilder:default=4317
 	Port int32 `json:"port,omitempty"`
+	// Zipkin defines the settings of the Zipkin provider.
+	// It can only be set when the type is "Zipkin".
+	//
+	// +optional
+	Zipkin *ZipkinTracingProvider `json:"zipkin,omitempty"`
+}
+
+// ZipkinTracingProvider defines the settings of the Zipkin tracing provider.
+type ZipkinTracingProvider struct {
+	// CollectorEndpoint is the API endpoint of the Zipkin collector the spans
+	// are sent to. Defaults to "/api/v2/spans".
+	//
+	// +optional
+	CollectorEndpoint *string `json:"collectorEndpoint,omitempty"`
+	// Enable128BitTraceID generates 128-bit trace IDs instead of 64-bit ones.
+	//
+	// +optional
+	Enable128BitTraceID *bool `json:"enable128BitTraceID,omitempty"`
+	// DisableSharedSpanContext creates a new span for the server side of the
+	// requests, instead of sharing the span context of the client.
+	//
+	// +optional
+	DisableSharedSpanContext *bool `json:"disableSharedSpanContext,omitempty"`
 }
 
 type CustomTagType string
@@ -16,6 +16,7 @@ import (
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/testing/protocmp"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 	"sigs.k8s.io/yaml"
 
 	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
@@ -62,6 +63,11 @@ func validateEnvoyProxySpec(spec *egcfgv1a1.EnvoyProxySpec) error {
 		errs = append(errs, validateProxyTelemetryErrs...)
 	}
 
+	validateProxyOverloadErrs := validateProxyOverload(spec)
+	if len(validateProxyOverloadErrs) != 0 {
+		errs = append(errs, validateProxyOverloadErrs...)
+	}
+
 	return utilerrors.NewAggregate(errs)
 }
 
@@ -96,7 +102,7 @@ func validateServiceType(spec *egcfgv1a1.EnvoyProxySpec) []error {
 func validateBootstrap(boostrapConfig *egcfgv1a1.ProxyBootstrap) error {
 	defaultBootstrap := &bootstrapv3.Bootstrap{}
 	// TODO: need validate when enable prometheus?
-	defaultBootstrapStr, err := bootstrap.GetRenderedBootstrapConfig(nil)
+	defaultBootstrapStr, err := bootstrap.GetRenderedBootstrapConfig(nil, nil)
 	if err != nil {
 		return err
 	}
@@ -173,6 +179,21 @@ func validateProxyTelemetry(spec *egcfgv1a1.EnvoyProxySpec) []error {
 		}
 	}
 
+	if spec != nil && spec.Telemetry.Tracing != nil {
+		errs = append(errs, validateProxyTracing(spec.Telemetry.Tracing)...)
+	}
+
+	return errs
+}
+
+func validateProxyTracing(tracing *egcfgv1a1.ProxyTracing) []error {
+	var errs []error
+
+	provider := tracing.Provider
+	if provider.Zipkin != nil && provider.Type != egcfgv1a1.TracingProviderTypeZipkin {
+		errs = append(errs, fmt.Errorf("unable to configure tracing when \"zipkin\" field is set with provider type %s", provider.Type))
+	}
+
 	return errs
 }
 
@@ -181,9 +202,19 @@ func validateProxyAccessLog(accessLog *egcfgv1a1.ProxyAccessLog) []error {
 		return nil
 	}
 
+	return validateProxyAccessLogSettings(accessLog.Settings)
+}
+
+// ValidateProxyAccessLogSettings validates the provided access log settings,
+// which are also set by the policies overriding the access logs of the EnvoyProxy.
+func ValidateProxyAccessLogSettings(settings []egcfgv1a1.ProxyAccessLogSetting) error {
+	return utilerrors.NewAggregate(validateProxyAccessLogSettings(settings))
+}
+
+func validateProxyAccessLogSettings(settings []egcfgv1a1.ProxyAccessLogSetting) []error {
 	var errs []error
 
-	for _, setting := range accessLog.Settings {
+	for _, setting := range settings {
 		switch setting.Format.Type {
 		case egcfgv1a1.ProxyAccessLogFormatTypeText:
 			if setting.Format.Text == nil {
@@ -197,6 +228,10 @@ func validateProxyAccessLog(accessLog *egcfgv1a1.ProxyAccessLog) []error {
 			}
 		}
 
+		if setting.Filter != nil {
+			errs = append(errs, validateProxyAccessLogFilter(setting.Filter)...)
+		}
+
 		for _, sink := range setting.Sinks {
 			switch sink.Type {
 			case egcfgv1a1.ProxyAccessLogSinkTypeFile:
@@ -209,9 +244,95 @@ func validateProxyAccessLog(accessLog *egcfgv1a1.ProxyAccessLog) []error {
 					err := fmt.Errorf("unable to configure access log when using OpenTelemetry sink type but \"openTelemetry\" field being empty")
 					errs = append(errs, err)
 				}
+			case egcfgv1a1.ProxyAccessLogSinkTypeALS:
+				if sink.ALS == nil {
+					err := fmt.Errorf("unable to configure access log when using ALS sink type but \"als\" field being empty")
+					errs = append(errs, err)
+				} else {
+					errs = append(errs, validateALSBackendRef(&sink.ALS.BackendRef)...)
+				}
 			}
 		}
 	}
 
 	return errs
 }
+
+func validateALSBackendRef(backendRef *gwapiv1b1.BackendObjectReference) []error {
+	var errs []error
+
+	if (backendRef.Group != nil && *backendRef.Group != "") ||
+		(backendRef.Kind != nil && *backendRef.Kind != "Service") {
+		errs = append(errs, fmt.Errorf("unable to configure ALS access log with backendRef %s, only Services are supported", backendRef.Name))
+	}
+	if backendRef.Port == nil {
+		errs = append(errs, fmt.Errorf("unable to configure ALS access log with backendRef %s without \"port\"", backendRef.Name))
+	}
+
+	return errs
+}
+
+func validateProxyAccessLogFilter(filter *egcfgv1a1.ProxyAccessLogFilter) []error {
+	var errs []error
+
+	for _, condition := range filter.Conditions {
+		set := 0
+		if condition.StatusCode != nil {
+			set++
+			statusCode := condition.StatusCode
+			switch {
+			case statusCode.Min == nil && statusCode.Max == nil:
+				errs = append(errs, fmt.Errorf("unable to configure access log filter when \"statusCode\" has neither \"min\" nor \"max\""))
+			case statusCode.Min != nil && statusCode.Max != nil && *statusCode.Min > *statusCode.Max:
+				errs = append(errs, fmt.Errorf("access log filter status code min %d is greater than max %d", *statusCode.Min, *statusCode.Max))
+			}
+		}
+		if condition.MinDuration != nil {
+			set++
+			if condition.MinDuration.Duration < 0 {
+				errs = append(errs, fmt.Errorf("access log filter minDuration %s is negative", condition.MinDuration.Duration))
+			}
+		}
+		if len(condition.ResponseFlags) > 0 {
+			set++
+		}
+		if condition.Header != nil {
+			set++
+		}
+		if condition.SamplePercent != nil {
+			set++
+		}
+		if set != 1 {
+			errs = append(errs, fmt.Errorf("unable to configure access log filter condition with %d fields set, exactly one is required", set))
+		}
+	}
+
+	return errs
+}
+
+func validateProxyOverload(spec *egcfgv1a1.EnvoyProxySpec) []error {
+	if spec == nil || spec.Overload == nil {
+		return nil
+	}
+
+	var errs []error
+
+	overload := spec.Overload
+	if overload.MaxHeapSize != nil && overload.MaxHeapSize.Value() <= 0 {
+		errs = append(errs, fmt.Errorf("overload maxHeapSize %s must be greater than 0", overload.MaxHeapSize.String()))
+	}
+
+	if len(overload.Actions) > 0 && overload.MaxHeapSize == nil {
+		errs = append(errs, fmt.Errorf("unable to configure overload actions without \"maxHeapSize\""))
+	}
+
+	actionTypes := make(map[egcfgv1a1.ProxyOverloadActionType]bool, len(overload.Actions))
+	for _, action := range overload.Actions {
+		if actionTypes[action.Type] {
+			errs = append(errs, fmt.Errorf("overload action %s is configured more than once", action.Type))
+		}
+		actionTypes[action.Type] = true
+	}
+
+	return errs
+}
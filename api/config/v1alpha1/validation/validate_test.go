@@ -14,8 +14,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/pointer"
+	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 
 	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
 )
@@ -34,6 +36,7 @@ var (
 )
 
 func TestValidateEnvoyProxy(t *testing.T) {
+	alsPort := gwapiv1b1.PortNumber(9000)
 	testCases := []struct {
 		name     string
 		proxy    *egcfgv1a1.EnvoyProxy
@@ -298,6 +301,349 @@ func TestValidateEnvoyProxy(t *testing.T) {
 			},
 			expected: false,
 		},
+		{
+			name: "valid ALS accesslog sink",
+			proxy: &egcfgv1a1.EnvoyProxy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "test",
+				},
+				Spec: egcfgv1a1.EnvoyProxySpec{
+					Telemetry: egcfgv1a1.ProxyTelemetry{
+						AccessLog: &egcfgv1a1.ProxyAccessLog{
+							Settings: []egcfgv1a1.ProxyAccessLogSetting{
+								{
+									Format: egcfgv1a1.ProxyAccessLogFormat{
+										Type: egcfgv1a1.ProxyAccessLogFormatTypeText,
+										Text: pointer.String("[%START_TIME%]"),
+									},
+									Sinks: []egcfgv1a1.ProxyAccessLogSink{
+										{
+											Type: egcfgv1a1.ProxyAccessLogSinkTypeALS,
+											ALS: &egcfgv1a1.ALSEnvoyProxyAccessLog{
+												BackendRef: gwapiv1b1.BackendObjectReference{
+													Name: "als",
+													Port: &alsPort,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "should invalid when accesslog enabled using ALS sink, but `als` field being empty",
+			proxy: &egcfgv1a1.EnvoyProxy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "test",
+				},
+				Spec: egcfgv1a1.EnvoyProxySpec{
+					Telemetry: egcfgv1a1.ProxyTelemetry{
+						AccessLog: &egcfgv1a1.ProxyAccessLog{
+							Settings: []egcfgv1a1.ProxyAccessLogSetting{
+								{
+									Format: egcfgv1a1.ProxyAccessLogFormat{
+										Type: egcfgv1a1.ProxyAccessLogFormatTypeText,
+										Text: pointer.String("[%START_TIME%]"),
+									},
+									Sinks: []egcfgv1a1.ProxyAccessLogSink{
+										{
+											Type: egcfgv1a1.ProxyAccessLogSinkTypeALS,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "should invalid when ALS accesslog sink has no port",
+			proxy: &egcfgv1a1.EnvoyProxy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "test",
+				},
+				Spec: egcfgv1a1.EnvoyProxySpec{
+					Telemetry: egcfgv1a1.ProxyTelemetry{
+						AccessLog: &egcfgv1a1.ProxyAccessLog{
+							Settings: []egcfgv1a1.ProxyAccessLogSetting{
+								{
+									Format: egcfgv1a1.ProxyAccessLogFormat{
+										Type: egcfgv1a1.ProxyAccessLogFormatTypeText,
+										Text: pointer.String("[%START_TIME%]"),
+									},
+									Sinks: []egcfgv1a1.ProxyAccessLogSink{
+										{
+											Type: egcfgv1a1.ProxyAccessLogSinkTypeALS,
+											ALS: &egcfgv1a1.ALSEnvoyProxyAccessLog{
+												BackendRef: gwapiv1b1.BackendObjectReference{
+													Name: "als",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "valid accesslog filter",
+			proxy: &egcfgv1a1.EnvoyProxy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "test",
+				},
+				Spec: egcfgv1a1.EnvoyProxySpec{
+					Telemetry: egcfgv1a1.ProxyTelemetry{
+						AccessLog: &egcfgv1a1.ProxyAccessLog{
+							Settings: []egcfgv1a1.ProxyAccessLogSetting{
+								{
+									Format: egcfgv1a1.ProxyAccessLogFormat{
+										Type: egcfgv1a1.ProxyAccessLogFormatTypeText,
+										Text: pointer.String("[%START_TIME%]"),
+									},
+									Filter: &egcfgv1a1.ProxyAccessLogFilter{
+										Conditions: []egcfgv1a1.ProxyAccessLogFilterCondition{
+											{
+												StatusCode: &egcfgv1a1.AccessLogStatusCodeRange{
+													Min: pointer.Uint32(500),
+												},
+											},
+											{
+												SamplePercent: pointer.Uint32(1),
+											},
+										},
+									},
+									Sinks: []egcfgv1a1.ProxyAccessLogSink{
+										{
+											Type: egcfgv1a1.ProxyAccessLogSinkTypeFile,
+											File: &egcfgv1a1.FileEnvoyProxyAccessLog{
+												Path: "/dev/stdout",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "should invalid when accesslog filter condition sets several fields",
+			proxy: &egcfgv1a1.EnvoyProxy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "test",
+				},
+				Spec: egcfgv1a1.EnvoyProxySpec{
+					Telemetry: egcfgv1a1.ProxyTelemetry{
+						AccessLog: &egcfgv1a1.ProxyAccessLog{
+							Settings: []egcfgv1a1.ProxyAccessLogSetting{
+								{
+									Format: egcfgv1a1.ProxyAccessLogFormat{
+										Type: egcfgv1a1.ProxyAccessLogFormatTypeText,
+										Text: pointer.String("[%START_TIME%]"),
+									},
+									Filter: &egcfgv1a1.ProxyAccessLogFilter{
+										Conditions: []egcfgv1a1.ProxyAccessLogFilterCondition{
+											{
+												StatusCode: &egcfgv1a1.AccessLogStatusCodeRange{
+													Min: pointer.Uint32(500),
+												},
+												SamplePercent: pointer.Uint32(1),
+											},
+										},
+									},
+									Sinks: []egcfgv1a1.ProxyAccessLogSink{
+										{
+											Type: egcfgv1a1.ProxyAccessLogSinkTypeFile,
+											File: &egcfgv1a1.FileEnvoyProxyAccessLog{
+												Path: "/dev/stdout",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "should invalid when accesslog filter status code min is greater than max",
+			proxy: &egcfgv1a1.EnvoyProxy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "test",
+				},
+				Spec: egcfgv1a1.EnvoyProxySpec{
+					Telemetry: egcfgv1a1.ProxyTelemetry{
+						AccessLog: &egcfgv1a1.ProxyAccessLog{
+							Settings: []egcfgv1a1.ProxyAccessLogSetting{
+								{
+									Format: egcfgv1a1.ProxyAccessLogFormat{
+										Type: egcfgv1a1.ProxyAccessLogFormatTypeText,
+										Text: pointer.String("[%START_TIME%]"),
+									},
+									Filter: &egcfgv1a1.ProxyAccessLogFilter{
+										Conditions: []egcfgv1a1.ProxyAccessLogFilterCondition{
+											{
+												StatusCode: &egcfgv1a1.AccessLogStatusCodeRange{
+													Min: pointer.Uint32(500),
+													Max: pointer.Uint32(400),
+												},
+											},
+										},
+									},
+									Sinks: []egcfgv1a1.ProxyAccessLogSink{
+										{
+											Type: egcfgv1a1.ProxyAccessLogSinkTypeFile,
+											File: &egcfgv1a1.FileEnvoyProxyAccessLog{
+												Path: "/dev/stdout",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "valid zipkin tracing",
+			proxy: &egcfgv1a1.EnvoyProxy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "test",
+				},
+				Spec: egcfgv1a1.EnvoyProxySpec{
+					Telemetry: egcfgv1a1.ProxyTelemetry{
+						Tracing: &egcfgv1a1.ProxyTracing{
+							Provider: egcfgv1a1.TracingProvider{
+								Type: egcfgv1a1.TracingProviderTypeZipkin,
+								Host: "zipkin.monitoring.svc",
+								Zipkin: &egcfgv1a1.ZipkinTracingProvider{
+									Enable128BitTraceID: pointer.Bool(true),
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "should invalid when zipkin tracing settings are set with another provider type",
+			proxy: &egcfgv1a1.EnvoyProxy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "test",
+				},
+				Spec: egcfgv1a1.EnvoyProxySpec{
+					Telemetry: egcfgv1a1.ProxyTelemetry{
+						Tracing: &egcfgv1a1.ProxyTracing{
+							Provider: egcfgv1a1.TracingProvider{
+								Type: egcfgv1a1.TracingProviderTypeDatadog,
+								Host: "datadog-agent.monitoring.svc",
+								Zipkin: &egcfgv1a1.ZipkinTracingProvider{
+									Enable128BitTraceID: pointer.Bool(true),
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "valid overload settings",
+			proxy: &egcfgv1a1.EnvoyProxy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "test",
+				},
+				Spec: egcfgv1a1.EnvoyProxySpec{
+					Overload: &egcfgv1a1.ProxyOverload{
+						MaxListenerConnections: pointer.Uint64(1000),
+						MaxHeapSize:            resource.NewQuantity(1<<30, resource.BinarySI),
+						Actions: []egcfgv1a1.ProxyOverloadAction{
+							{
+								Type:                 egcfgv1a1.ShrinkHeapOverloadAction,
+								HeapThresholdPercent: 90,
+							},
+							{
+								Type:                 egcfgv1a1.StopAcceptingConnectionsOverloadAction,
+								HeapThresholdPercent: 95,
+							},
+						},
+					},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "should invalid when overload actions are set without maxHeapSize",
+			proxy: &egcfgv1a1.EnvoyProxy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "test",
+				},
+				Spec: egcfgv1a1.EnvoyProxySpec{
+					Overload: &egcfgv1a1.ProxyOverload{
+						Actions: []egcfgv1a1.ProxyOverloadAction{
+							{
+								Type:                 egcfgv1a1.ShrinkHeapOverloadAction,
+								HeapThresholdPercent: 90,
+							},
+						},
+					},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "should invalid when an overload action is set twice",
+			proxy: &egcfgv1a1.EnvoyProxy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "test",
+				},
+				Spec: egcfgv1a1.EnvoyProxySpec{
+					Overload: &egcfgv1a1.ProxyOverload{
+						MaxHeapSize: resource.NewQuantity(1<<30, resource.BinarySI),
+						Actions: []egcfgv1a1.ProxyOverloadAction{
+							{
+								Type:                 egcfgv1a1.ShrinkHeapOverloadAction,
+								HeapThresholdPercent: 90,
+							},
+							{
+								Type:                 egcfgv1a1.ShrinkHeapOverloadAction,
+								HeapThresholdPercent: 95,
+							},
+						},
+					},
+				},
+			},
+			expected: false,
+		},
 	}
 
 	for i := range testCases {
@@ -18,6 +18,122 @@ import (
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ALSEnvoyProxyAccessLog) DeepCopyInto(out *ALSEnvoyProxyAccessLog) {
+	*out = *in
+	in.BackendRef.DeepCopyInto(&out.BackendRef)
+	if in.LogName != nil {
+		in, out := &in.LogName, &out.LogName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(ALSEnvoyProxyAccessLogType)
+		**out = **in
+	}
+	if in.BufferFlushInterval != nil {
+		in, out := &in.BufferFlushInterval, &out.BufferFlushInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.BufferSizeBytes != nil {
+		in, out := &in.BufferSizeBytes, &out.BufferSizeBytes
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(ALSEnvoyProxyHTTPAccessLogConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ALSEnvoyProxyAccessLog.
+func (in *ALSEnvoyProxyAccessLog) DeepCopy() *ALSEnvoyProxyAccessLog {
+	if in == nil {
+		return nil
+	}
+	out := new(ALSEnvoyProxyAccessLog)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ALSEnvoyProxyHTTPAccessLogConfig) DeepCopyInto(out *ALSEnvoyProxyHTTPAccessLogConfig) {
+	*out = *in
+	if in.RequestHeaders != nil {
+		in, out := &in.RequestHeaders, &out.RequestHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResponseHeaders != nil {
+		in, out := &in.ResponseHeaders, &out.ResponseHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResponseTrailers != nil {
+		in, out := &in.ResponseTrailers, &out.ResponseTrailers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ALSEnvoyProxyHTTPAccessLogConfig.
+func (in *ALSEnvoyProxyHTTPAccessLogConfig) DeepCopy() *ALSEnvoyProxyHTTPAccessLogConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ALSEnvoyProxyHTTPAccessLogConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessLogHeaderMatch) DeepCopyInto(out *AccessLogHeaderMatch) {
+	*out = *in
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessLogHeaderMatch.
+func (in *AccessLogHeaderMatch) DeepCopy() *AccessLogHeaderMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessLogHeaderMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessLogStatusCodeRange) DeepCopyInto(out *AccessLogStatusCodeRange) {
+	*out = *in
+	if in.Min != nil {
+		in, out := &in.Min, &out.Min
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Max != nil {
+		in, out := &in.Max, &out.Max
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessLogStatusCodeRange.
+func (in *AccessLogStatusCodeRange) DeepCopy() *AccessLogStatusCodeRange {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessLogStatusCodeRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomTag) DeepCopyInto(out *CustomTag) {
 	*out = *in
@@ -387,6 +503,11 @@ func (in *EnvoyProxyKubernetesProvider) DeepCopyInto(out *EnvoyProxyKubernetesPr
 		*out = new(KubernetesServiceSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvoyProxyKubernetesProvider.
@@ -471,6 +592,16 @@ func (in *EnvoyProxySpec) DeepCopyInto(out *EnvoyProxySpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.RoutingType != nil {
+		in, out := &in.RoutingType, &out.RoutingType
+		*out = new(RoutingType)
+		**out = **in
+	}
+	if in.Overload != nil {
+		in, out := &in.Overload, &out.Overload
+		*out = new(ProxyOverload)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvoyProxySpec.
@@ -972,6 +1103,73 @@ func (in *ProxyAccessLog) DeepCopy() *ProxyAccessLog {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxyAccessLogFilter) DeepCopyInto(out *ProxyAccessLogFilter) {
+	*out = *in
+	if in.Operator != nil {
+		in, out := &in.Operator, &out.Operator
+		*out = new(ProxyAccessLogFilterOperator)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]ProxyAccessLogFilterCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyAccessLogFilter.
+func (in *ProxyAccessLogFilter) DeepCopy() *ProxyAccessLogFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyAccessLogFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxyAccessLogFilterCondition) DeepCopyInto(out *ProxyAccessLogFilterCondition) {
+	*out = *in
+	if in.StatusCode != nil {
+		in, out := &in.StatusCode, &out.StatusCode
+		*out = new(AccessLogStatusCodeRange)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MinDuration != nil {
+		in, out := &in.MinDuration, &out.MinDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ResponseFlags != nil {
+		in, out := &in.ResponseFlags, &out.ResponseFlags
+		*out = make([]AccessLogResponseFlag, len(*in))
+		copy(*out, *in)
+	}
+	if in.Header != nil {
+		in, out := &in.Header, &out.Header
+		*out = new(AccessLogHeaderMatch)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SamplePercent != nil {
+		in, out := &in.SamplePercent, &out.SamplePercent
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyAccessLogFilterCondition.
+func (in *ProxyAccessLogFilterCondition) DeepCopy() *ProxyAccessLogFilterCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyAccessLogFilterCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProxyAccessLogFormat) DeepCopyInto(out *ProxyAccessLogFormat) {
 	*out = *in
@@ -1003,6 +1201,11 @@ func (in *ProxyAccessLogFormat) DeepCopy() *ProxyAccessLogFormat {
 func (in *ProxyAccessLogSetting) DeepCopyInto(out *ProxyAccessLogSetting) {
 	*out = *in
 	in.Format.DeepCopyInto(&out.Format)
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(ProxyAccessLogFilter)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Sinks != nil {
 		in, out := &in.Sinks, &out.Sinks
 		*out = make([]ProxyAccessLogSink, len(*in))
@@ -1035,6 +1238,11 @@ func (in *ProxyAccessLogSink) DeepCopyInto(out *ProxyAccessLogSink) {
 		*out = new(OpenTelemetryEnvoyProxyAccessLog)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ALS != nil {
+		in, out := &in.ALS, &out.ALS
+		*out = new(ALSEnvoyProxyAccessLog)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyAccessLogSink.
@@ -1121,6 +1329,51 @@ func (in *ProxyMetrics) DeepCopy() *ProxyMetrics {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxyOverload) DeepCopyInto(out *ProxyOverload) {
+	*out = *in
+	if in.MaxListenerConnections != nil {
+		in, out := &in.MaxListenerConnections, &out.MaxListenerConnections
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.MaxHeapSize != nil {
+		in, out := &in.MaxHeapSize, &out.MaxHeapSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Actions != nil {
+		in, out := &in.Actions, &out.Actions
+		*out = make([]ProxyOverloadAction, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyOverload.
+func (in *ProxyOverload) DeepCopy() *ProxyOverload {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyOverload)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxyOverloadAction) DeepCopyInto(out *ProxyOverloadAction) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyOverloadAction.
+func (in *ProxyOverloadAction) DeepCopy() *ProxyOverloadAction {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyOverloadAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProxyTelemetry) DeepCopyInto(out *ProxyTelemetry) {
 	*out = *in
@@ -1166,7 +1419,7 @@ func (in *ProxyTracing) DeepCopyInto(out *ProxyTracing) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
-	out.Provider = in.Provider
+	in.Provider.DeepCopyInto(&out.Provider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyTracing.
@@ -1208,6 +1461,11 @@ func (in *RateLimitDatabaseBackend) DeepCopyInto(out *RateLimitDatabaseBackend)
 		*out = new(RateLimitRedisSettings)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Memcached != nil {
+		in, out := &in.Memcached, &out.Memcached
+		*out = new(RateLimitMemcachedSettings)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitDatabaseBackend.
@@ -1220,9 +1478,49 @@ func (in *RateLimitDatabaseBackend) DeepCopy() *RateLimitDatabaseBackend {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitMemcachedSettings) DeepCopyInto(out *RateLimitMemcachedSettings) {
+	*out = *in
+	if in.URLs != nil {
+		in, out := &in.URLs, &out.URLs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitMemcachedSettings.
+func (in *RateLimitMemcachedSettings) DeepCopy() *RateLimitMemcachedSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitMemcachedSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RateLimitRedisSettings) DeepCopyInto(out *RateLimitRedisSettings) {
 	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(RedisType)
+		**out = **in
+	}
+	if in.Sentinel != nil {
+		in, out := &in.Sentinel, &out.Sentinel
+		*out = new(RedisSentinelSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Cluster != nil {
+		in, out := &in.Cluster, &out.Cluster
+		*out = new(RedisClusterSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(RedisAuth)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TLS != nil {
 		in, out := &in.TLS, &out.TLS
 		*out = new(RedisTLSSettings)
@@ -1240,6 +1538,67 @@ func (in *RateLimitRedisSettings) DeepCopy() *RateLimitRedisSettings {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisAuth) DeepCopyInto(out *RedisAuth) {
+	*out = *in
+	if in.Username != nil {
+		in, out := &in.Username, &out.Username
+		*out = new(string)
+		**out = **in
+	}
+	in.PasswordRef.DeepCopyInto(&out.PasswordRef)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisAuth.
+func (in *RedisAuth) DeepCopy() *RedisAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisClusterSettings) DeepCopyInto(out *RedisClusterSettings) {
+	*out = *in
+	if in.URLs != nil {
+		in, out := &in.URLs, &out.URLs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisClusterSettings.
+func (in *RedisClusterSettings) DeepCopy() *RedisClusterSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisClusterSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisSentinelSettings) DeepCopyInto(out *RedisSentinelSettings) {
+	*out = *in
+	if in.URLs != nil {
+		in, out := &in.URLs, &out.URLs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisSentinelSettings.
+func (in *RedisSentinelSettings) DeepCopy() *RedisSentinelSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisSentinelSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RedisTLSSettings) DeepCopyInto(out *RedisTLSSettings) {
 	*out = *in
@@ -1283,6 +1642,11 @@ func (in *RequestHeaderCustomTag) DeepCopy() *RequestHeaderCustomTag {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TracingProvider) DeepCopyInto(out *TracingProvider) {
 	*out = *in
+	if in.Zipkin != nil {
+		in, out := &in.Zipkin, &out.Zipkin
+		*out = new(ZipkinTracingProvider)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracingProvider.
@@ -1319,3 +1683,33 @@ func (in *XDSTranslatorHooks) DeepCopy() *XDSTranslatorHooks {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZipkinTracingProvider) DeepCopyInto(out *ZipkinTracingProvider) {
+	*out = *in
+	if in.CollectorEndpoint != nil {
+		in, out := &in.CollectorEndpoint, &out.CollectorEndpoint
+		*out = new(string)
+		**out = **in
+	}
+	if in.Enable128BitTraceID != nil {
+		in, out := &in.Enable128BitTraceID, &out.Enable128BitTraceID
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DisableSharedSpanContext != nil {
+		in, out := &in.DisableSharedSpanContext, &out.DisableSharedSpanContext
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZipkinTracingProvider.
+func (in *ZipkinTracingProvider) DeepCopy() *ZipkinTracingProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(ZipkinTracingProvider)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,98 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwapiv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
+)
+
+const (
+	// KindAccessLogPolicy is the name of the AccessLogPolicy kind.
+	KindAccessLogPolicy = "AccessLogPolicy"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.conditions[?(@.type=="Accepted")].reason`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// AccessLogPolicy allows the user to override the access logging configured
+// in the EnvoyProxy for the listeners of a Gateway or for one of its listeners,
+// or to disable the access logs of the requests of an HTTPRoute or a GRPCRoute.
+type AccessLogPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of AccessLogPolicy.
+	Spec AccessLogPolicySpec `json:"spec"`
+
+	// Status defines the current status of AccessLogPolicy.
+	Status AccessLogPolicyStatus `json:"status,omitempty"`
+}
+
+// AccessLogPolicySpec defines the desired state of AccessLogPolicy.
+type AccessLogPolicySpec struct {
+	// TargetRef is the name of the Gateway API resource this policy
+	// is being attached to.
+	// Attaching to a Gateway applies the policy to all its listeners, and
+	// setting the SectionName applies it to the listener with this name only,
+	// taking precedence over a policy attached to the whole Gateway.
+	// Attaching to an HTTPRoute or a GRPCRoute only supports disabling the
+	// access logs of the requests of the route.
+	// This Policy and the TargetRef MUST be in the same namespace
+	// for this Policy to have effect.
+	TargetRef AccessLogPolicyTargetReference `json:"targetRef"`
+	// Disable disables the access logs of the target if set to true.
+	//
+	// +optional
+	Disable bool `json:"disable,omitempty"`
+	// Settings replace the access log settings of the EnvoyProxy for the
+	// listeners of the target. They can't be set together with Disable,
+	// nor when targeting a route.
+	//
+	// +optional
+	Settings []egcfgv1a1.ProxyAccessLogSetting `json:"settings,omitempty"`
+}
+
+// AccessLogPolicyTargetReference identifies the Gateway, the listener of a
+// Gateway or the route an AccessLogPolicy is attached to.
+type AccessLogPolicyTargetReference struct {
+	gwapiv1a2.PolicyTargetReference `json:",inline"`
+
+	// SectionName is the name of the listener of the target Gateway.
+	// It can only be set when targeting a Gateway.
+	//
+	// +optional
+	SectionName *gwapiv1b1.SectionName `json:"sectionName,omitempty"`
+}
+
+// AccessLogPolicyStatus defines the state of AccessLogPolicy
+type AccessLogPolicyStatus struct {
+	// Conditions describe the current conditions of the AccessLogPolicy.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=8
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// AccessLogPolicyList contains a list of AccessLogPolicy resources.
+type AccessLogPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccessLogPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AccessLogPolicy{}, &AccessLogPolicyList{})
+}
@@ -0,0 +1,97 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// KindBackend is the name of the Backend kind.
+	KindBackend = "Backend"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// Backend allows the user to route the requests to endpoints outside of the
+// cluster, such as the FQDN of an external API, without creating Kubernetes
+// Services. A Backend can be referenced by the backendRefs of the routes.
+type Backend struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of Backend.
+	Spec BackendSpec `json:"spec"`
+}
+
+// BackendSpec defines the desired state of Backend.
+type BackendSpec struct {
+	// Endpoints defines the endpoints the requests are load balanced across.
+	// The FQDN endpoints are resolved periodically with DNS, and all their
+	// addresses are used. The port of the backendRefs referencing the Backend
+	// is ignored.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	Endpoints []BackendEndpoint `json:"endpoints"`
+}
+
+// BackendEndpoint defines an endpoint of a Backend. Exactly one of FQDN and
+// IP must be set.
+type BackendEndpoint struct {
+	// FQDN defines an endpoint identified by a fully qualified domain name.
+	//
+	// +optional
+	FQDN *FQDNEndpoint `json:"fqdn,omitempty"`
+	// IP defines an endpoint identified by an IPv4 or IPv6 address.
+	//
+	// +optional
+	IP *IPEndpoint `json:"ip,omitempty"`
+}
+
+// FQDNEndpoint defines an endpoint identified by a fully qualified domain name.
+type FQDNEndpoint struct {
+	// Hostname is the fully qualified domain name of the endpoint, e.g.
+	// "api.example.com".
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^(([a-z0-9][-a-z0-9]*)?[a-z0-9])(\.([a-z0-9][-a-z0-9]*)?[a-z0-9])*$`
+	Hostname string `json:"hostname"`
+	// Port is the port of the endpoint.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+}
+
+// IPEndpoint defines an endpoint identified by an IP address.
+type IPEndpoint struct {
+	// Address is the IPv4 or IPv6 address of the endpoint.
+	//
+	// +kubebuilder:validation:MinLength=2
+	// +kubebuilder:validation:MaxLength=45
+	Address string `json:"address"`
+	// Port is the port of the endpoint.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+}
+
+//+kubebuilder:object:root=true
+
+// BackendList contains a list of Backend resources.
+type BackendList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Backend `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Backend{}, &BackendList{})
+}
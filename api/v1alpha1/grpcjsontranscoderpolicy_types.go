@@ -0,0 +1,113 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwapiv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+const (
+	// KindGRPCJSONTranscoderPolicy is the name of the GRPCJSONTranscoderPolicy kind.
+	KindGRPCJSONTranscoderPolicy = "GRPCJSONTranscoderPolicy"
+
+	// GRPCJSONTranscoderDescriptorSetKey is the key within the ConfigMap or
+	// the Secret referenced by a GRPCJSONTranscoderPolicy that holds the
+	// binary protobuf descriptor set.
+	GRPCJSONTranscoderDescriptorSetKey = "descriptor.pb"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.conditions[?(@.type=="Accepted")].reason`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// GRPCJSONTranscoderPolicy allows the user to expose the methods of the gRPC
+// services of a GRPCRoute as REST/JSON endpoints. The JSON requests are
+// transcoded to gRPC requests, according to the google.api.http annotations
+// of the proto files, and the gRPC responses are transcoded back to JSON.
+type GRPCJSONTranscoderPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of GRPCJSONTranscoderPolicy.
+	Spec GRPCJSONTranscoderPolicySpec `json:"spec"`
+
+	// Status defines the current status of GRPCJSONTranscoderPolicy.
+	Status GRPCJSONTranscoderPolicyStatus `json:"status,omitempty"`
+}
+
+// GRPCJSONTranscoderPolicySpec defines the desired state of GRPCJSONTranscoderPolicy.
+type GRPCJSONTranscoderPolicySpec struct {
+	// TargetRef is the name of the GRPCRoute this policy is being attached to.
+	// The JSON requests are transcoded when they match a rule of the GRPCRoute.
+	// This Policy and the TargetRef MUST be in the same namespace
+	// for this Policy to have effect.
+	TargetRef gwapiv1a2.PolicyTargetReference `json:"targetRef"`
+	// DescriptorSetRef references a ConfigMap or a Secret, in the same
+	// namespace as the policy, holding the binary protobuf descriptor set of
+	// the gRPC services under the "descriptor.pb" key. The descriptor set must
+	// include the imports of the proto files, e.g. generated with
+	// `protoc --include_imports --descriptor_set_out=descriptor.pb`.
+	DescriptorSetRef gwapiv1b1.LocalObjectReference `json:"descriptorSetRef"`
+	// Services are the fully qualified names of the gRPC services,
+	// i.e. "package.Service", whose methods are exposed as REST/JSON endpoints.
+	//
+	// +kubebuilder:validation:MinItems=1
+	Services []string `json:"services"`
+	// PrintOptions defines how the gRPC responses are printed as JSON.
+	//
+	// +optional
+	PrintOptions *GRPCJSONPrintOptions `json:"printOptions,omitempty"`
+}
+
+// GRPCJSONPrintOptions defines how the gRPC responses are printed as JSON.
+type GRPCJSONPrintOptions struct {
+	// AddWhitespace adds spaces, line breaks and indentation to make the
+	// JSON output easy to read.
+	//
+	// +optional
+	AddWhitespace bool `json:"addWhitespace,omitempty"`
+	// AlwaysPrintPrimitiveFields prints the primitive fields even if their
+	// values are the default values, which are omitted otherwise.
+	//
+	// +optional
+	AlwaysPrintPrimitiveFields bool `json:"alwaysPrintPrimitiveFields,omitempty"`
+	// AlwaysPrintEnumsAsInts prints the enums as integers instead of strings.
+	//
+	// +optional
+	AlwaysPrintEnumsAsInts bool `json:"alwaysPrintEnumsAsInts,omitempty"`
+	// PreserveProtoFieldNames uses the field names of the proto files instead
+	// of their lowerCamelCase JSON names.
+	//
+	// +optional
+	PreserveProtoFieldNames bool `json:"preserveProtoFieldNames,omitempty"`
+}
+
+// GRPCJSONTranscoderPolicyStatus defines the state of GRPCJSONTranscoderPolicy
+type GRPCJSONTranscoderPolicyStatus struct {
+	// Conditions describe the current conditions of the GRPCJSONTranscoderPolicy.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=8
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// GRPCJSONTranscoderPolicyList contains a list of GRPCJSONTranscoderPolicy resources.
+type GRPCJSONTranscoderPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GRPCJSONTranscoderPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&GRPCJSONTranscoderPolicy{}, &GRPCJSONTranscoderPolicyList{})
+}
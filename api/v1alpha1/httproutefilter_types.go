@@ -0,0 +1,241 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+const (
+	// KindHTTPRouteFilter is the name of the HTTPRouteFilter kind.
+	KindHTTPRouteFilter = "HTTPRouteFilter"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// HTTPRouteFilter is a custom Envoy Gateway HTTPRouteFilter which provides extended
+// traffic processing options that are not available in the Gateway API filters,
+// such as additional redirect status codes or regex based path rewrites.
+type HTTPRouteFilter struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of HTTPRouteFilter.
+	Spec HTTPRouteFilterSpec `json:"spec"`
+}
+
+// HTTPRouteFilterSpec defines the desired state of HTTPRouteFilter.
+type HTTPRouteFilterSpec struct {
+	// RequestRedirect extends the RequestRedirect filter of the HTTPRoute rule
+	// referencing this filter. If the rule has no RequestRedirect filter, the
+	// request is redirected to its original URL modified by the fields set here.
+	//
+	// +optional
+	RequestRedirect *HTTPRequestRedirectFilter `json:"requestRedirect,omitempty"`
+	// URLRewrite extends the URLRewrite filter of the HTTPRoute rule
+	// referencing this filter. If the rule has no URLRewrite filter, the
+	// request is rewritten with the fields set here only.
+	//
+	// +optional
+	URLRewrite *HTTPURLRewriteFilter `json:"urlRewrite,omitempty"`
+	// RequestMirror mirrors a percentage of the requests matching the
+	// HTTPRoute rule referencing this filter to an additional backend.
+	// Several HTTPRouteFilters with a RequestMirror can be referenced by
+	// the same rule to mirror the requests to several backends.
+	//
+	// +optional
+	RequestMirror *HTTPRequestMirrorFilter `json:"requestMirror,omitempty"`
+	// Protocols configures the protocol features of the requests matching
+	// the route rule referencing this filter. By default, WebSocket upgrades
+	// are enabled for the HTTPRoute rules, and the gRPC-Web bridge and the
+	// gRPC statistics are enabled for the GRPCRoute rules.
+	//
+	// +optional
+	Protocols *HTTPProtocolsFilter `json:"protocols,omitempty"`
+	// Backend configures the backend of the HTTPRoute or GRPCRoute backendRef
+	// referencing this filter in its filters. A filter with a backend can only
+	// be referenced by backendRefs, and can't configure the other fields.
+	//
+	// +optional
+	Backend *HTTPBackendFilter `json:"backend,omitempty"`
+}
+
+// HTTPBackendFilter defines the options of a backend of a route rule.
+type HTTPBackendFilter struct {
+	// Priority is the failover priority of the backend, 0 being the highest
+	// priority. The requests are routed to the backends of the highest priority,
+	// and fail over to the backends of the next priority when the backends of
+	// a priority don't have enough healthy endpoints. The endpoints are unhealthy
+	// when they are not ready, or when they are ejected by the outlier detection
+	// of Envoy. Envoy doesn't actively health check the endpoints, so a ready
+	// endpoint only becomes unhealthy after failing requests. Defaults to 0.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=127
+	Priority *int32 `json:"priority,omitempty"`
+}
+
+// HTTPProtocolsFilter defines the protocol features that can be disabled
+// for the requests matching a route rule.
+type HTTPProtocolsFilter struct {
+	// DisableWebSocket disables the WebSocket upgrades of the requests.
+	// It can only be set for the HTTPRoute rules.
+	//
+	// +optional
+	DisableWebSocket *bool `json:"disableWebSocket,omitempty"`
+	// DisableGRPCWeb disables the translation of the gRPC-Web requests
+	// to gRPC. It can only be set for the GRPCRoute rules.
+	//
+	// +optional
+	DisableGRPCWeb *bool `json:"disableGRPCWeb,omitempty"`
+	// DisableGRPCStats disables the collection of the gRPC statistics
+	// of the requests. It can only be set for the GRPCRoute rules.
+	//
+	// +optional
+	DisableGRPCStats *bool `json:"disableGRPCStats,omitempty"`
+}
+
+// HTTPRequestRedirectFilter defines the redirect options that extend the
+// Gateway API HTTPRequestRedirectFilter.
+type HTTPRequestRedirectFilter struct {
+	// StatusCode is the HTTP status code to be used in the redirect response.
+	// It overrides the status code of the Gateway API RequestRedirect filter.
+	//
+	// +optional
+	// +kubebuilder:validation:Enum=301;302;303;307;308
+	StatusCode *int `json:"statusCode,omitempty"`
+	// StripQuery removes the query string of the request from the redirect URL.
+	//
+	// +optional
+	StripQuery *bool `json:"stripQuery,omitempty"`
+	// Path defines a path rewrite for the redirect URL.
+	// It overrides the path of the Gateway API RequestRedirect filter.
+	//
+	// +optional
+	Path *HTTPPathModifier `json:"path,omitempty"`
+}
+
+// HTTPURLRewriteFilter defines the rewrite options that extend the
+// Gateway API HTTPURLRewriteFilter.
+type HTTPURLRewriteFilter struct {
+	// Path defines a path rewrite of the request forwarded to the backend.
+	// It can't be used together with the path of the Gateway API URLRewrite filter.
+	// The pattern must be a valid RE2 regular expression, able to match the
+	// paths selected by the Exact, PathPrefix and RegularExpression path
+	// matches of the rule, otherwise the HTTPRoute is not accepted.
+	//
+	// +optional
+	Path *HTTPPathModifier `json:"path,omitempty"`
+	// Hostname defines a hostname rewrite of the request forwarded to the backend.
+	// It can't be used together with the hostname of the Gateway API URLRewrite filter.
+	//
+	// +optional
+	Hostname *HTTPHostnameModifier `json:"hostname,omitempty"`
+}
+
+// HTTPRequestMirrorFilter defines a backend requests are mirrored to, for a
+// percentage of the requests.
+type HTTPRequestMirrorFilter struct {
+	// BackendRef references a resource where mirrored requests are sent.
+	// The responses of the mirrored requests are ignored.
+	//
+	// Support and references follow the same rules as the BackendRef of the
+	// Gateway API RequestMirror filter.
+	BackendRef gwapiv1b1.BackendObjectReference `json:"backendRef"`
+	// Percent is the percentage of the requests that are mirrored.
+	// All the requests are mirrored if unset.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Percent *int32 `json:"percent,omitempty"`
+}
+
+// HTTPHostnameModifierType defines the type of hostname modifier.
+// +kubebuilder:validation:Enum=Header;Backend
+type HTTPHostnameModifierType string
+
+const (
+	// HeaderHTTPHostnameModifier rewrites the hostname with the value of a
+	// request header.
+	HeaderHTTPHostnameModifier HTTPHostnameModifierType = "Header"
+	// BackendHTTPHostnameModifier rewrites the hostname with the DNS name
+	// of the backend the request is forwarded to. It is only supported for
+	// the backends resolved with DNS, i.e. the FQDN endpoints of a Backend
+	// and the ExternalName Services.
+	BackendHTTPHostnameModifier HTTPHostnameModifierType = "Backend"
+)
+
+// HTTPHostnameModifier defines how the hostname of the request is rewritten.
+// +union
+type HTTPHostnameModifier struct {
+	// Type defines the type of hostname modifier.
+	// Valid HTTPHostnameModifierType values are "Header" and "Backend".
+	//
+	// +unionDiscriminator
+	Type HTTPHostnameModifierType `json:"type"`
+	// Header is the name of the request header whose value is used
+	// as the hostname. It must be set for the "Header" type.
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	Header *string `json:"header,omitempty"`
+}
+
+// HTTPPathModifierType defines the type of path modifier.
+// +kubebuilder:validation:Enum=ReplaceRegexMatch
+type HTTPPathModifierType string
+
+const (
+	// RegexHTTPPathModifier rewrites the portions of the path that match
+	// a regular expression.
+	RegexHTTPPathModifier HTTPPathModifierType = "ReplaceRegexMatch"
+)
+
+// HTTPPathModifier defines an extended path modifier.
+// +union
+type HTTPPathModifier struct {
+	// Type defines the type of path modifier.
+	// Valid HTTPPathModifierType values are "ReplaceRegexMatch".
+	//
+	// +unionDiscriminator
+	Type HTTPPathModifierType `json:"type"`
+	// ReplaceRegexMatch defines a path regex rewrite.
+	//
+	// +optional
+	ReplaceRegexMatch *ReplaceRegexMatch `json:"replaceRegexMatch,omitempty"`
+}
+
+// ReplaceRegexMatch defines a regular expression and the substitution
+// used to rewrite the portions of the path matching it.
+type ReplaceRegexMatch struct {
+	// Pattern matches a regular expression against the value of the path.
+	// Pattern follows the RE2 syntax, see https://github.com/google/re2/wiki/Syntax
+	// for more details.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Pattern string `json:"pattern"`
+	// Substitution is the expression used to replace the portions of the path
+	// matching the pattern. Capture groups of the pattern can be referenced
+	// in the substitution, e.g. "\1" for the first capture group.
+	Substitution string `json:"substitution"`
+}
+
+//+kubebuilder:object:root=true
+
+// HTTPRouteFilterList contains a list of HTTPRouteFilter resources.
+type HTTPRouteFilterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []HTTPRouteFilter `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&HTTPRouteFilter{}, &HTTPRouteFilterList{})
+}
@@ -0,0 +1,213 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwapiv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+const (
+	// KindL4TrafficPolicy is the name of the L4TrafficPolicy kind.
+	KindL4TrafficPolicy = "L4TrafficPolicy"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.conditions[?(@.type=="Accepted")].reason`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// L4TrafficPolicy allows the user to configure the behavior of the TCP and
+// UDP proxies of the TCP, TLS and UDP listeners, such as the idle timeouts
+// or the number of connection attempts to the backends.
+type L4TrafficPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of L4TrafficPolicy.
+	Spec L4TrafficPolicySpec `json:"spec"`
+
+	// Status defines the current status of L4TrafficPolicy.
+	Status L4TrafficPolicyStatus `json:"status,omitempty"`
+}
+
+// L4TrafficPolicySpec defines the desired state of L4TrafficPolicy.
+type L4TrafficPolicySpec struct {
+	// TargetRef is the name of the Gateway API resource this policy
+	// is being attached to.
+	// Attaching to a Gateway applies the policy to all its TCP, TLS and UDP
+	// listeners, and setting the SectionName applies it to the listener with
+	// this name only, taking precedence over a policy attached to the whole
+	// Gateway. Attaching to a TCPRoute, a TLSRoute or a UDPRoute applies the
+	// policy to the listeners the route is attached to, and takes precedence
+	// over the policies attached to their Gateway and listeners.
+	// This Policy and the TargetRef MUST be in the same namespace
+	// for this Policy to have effect.
+	TargetRef L4TrafficPolicyTargetReference `json:"targetRef"`
+	// TCP defines the settings of the TCP proxy of the TCP and TLS listeners.
+	//
+	// +optional
+	TCP *TCPProxySettings `json:"tcp,omitempty"`
+	// UDP defines the settings of the UDP proxy of the UDP listeners.
+	//
+	// +optional
+	UDP *UDPProxySettings `json:"udp,omitempty"`
+}
+
+// L4TrafficPolicyTargetReference identifies the Gateway, the listener of a
+// Gateway or the route a L4TrafficPolicy is attached to.
+type L4TrafficPolicyTargetReference struct {
+	gwapiv1a2.PolicyTargetReference `json:",inline"`
+
+	// SectionName is the name of the listener of the target Gateway.
+	// It can only be set when targeting a Gateway.
+	//
+	// +optional
+	SectionName *gwapiv1b1.SectionName `json:"sectionName,omitempty"`
+}
+
+// TCPProxySettings defines the settings of a TCP proxy.
+type TCPProxySettings struct {
+	// IdleTimeout is the time after which a connection is closed when
+	// neither the downstream nor the upstream have sent any data.
+	// If unset, connections are closed after one hour of inactivity.
+	// A value of 0s disables the idle timeout.
+	//
+	// +optional
+	// +kubebuilder:validation:Format=duration
+	IdleTimeout *metav1.Duration `json:"idleTimeout,omitempty"`
+	// MaxConnectionDuration is the maximum duration of a connection,
+	// after which it is closed. If unset, the duration is not limited.
+	//
+	// +optional
+	// +kubebuilder:validation:Format=duration
+	MaxConnectionDuration *metav1.Duration `json:"maxConnectionDuration,omitempty"`
+	// MaxConnectAttempts is the maximum number of unsuccessful connection
+	// attempts to the backends before the connection is closed.
+	// If unset, one attempt is made.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxConnectAttempts *uint32 `json:"maxConnectAttempts,omitempty"`
+	// AccessLog defines when the access logs of the connections are written.
+	// By default, a connection is logged once, when it is closed.
+	//
+	// +optional
+	AccessLog *TCPAccessLogSettings `json:"accessLog,omitempty"`
+	// RateLimit limits the rate of the new connections of the listeners.
+	// The connections over the limit are closed as soon as they are accepted.
+	//
+	// +optional
+	RateLimit *TCPRateLimit `json:"rateLimit,omitempty"`
+}
+
+// TCPRateLimit defines the rate limits of the new connections of the TCP
+// and TLS listeners.
+// +union
+type TCPRateLimit struct {
+	// Type decides the scope for the RateLimits.
+	// Valid RateLimitType values are "Global" or "Local".
+	//
+	// +unionDiscriminator
+	Type RateLimitType `json:"type"`
+	// Global defines global rate limits of the connections, shared by all
+	// the Envoy proxies through the rate limit service. Global rate limits
+	// require the rate limit service to be enabled in the EnvoyGateway
+	// configuration.
+	//
+	// +optional
+	Global *TCPGlobalRateLimit `json:"global,omitempty"`
+	// Local defines a local rate limit of the connections, enforced by each
+	// Envoy proxy for each listener.
+	//
+	// +optional
+	Local *TCPLocalRateLimit `json:"local,omitempty"`
+}
+
+// TCPGlobalRateLimit defines global rate limits of the new connections.
+type TCPGlobalRateLimit struct {
+	// Rules are a list of connection rate limits. Each rule counts the new
+	// connections of the listeners, and a connection is closed if any rule
+	// has reached its limit.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	Rules []TCPRateLimitRule `json:"rules"`
+}
+
+// TCPRateLimitRule defines a limit of the new connections.
+type TCPRateLimitRule struct {
+	// SourceIP defines how the connections are counted towards the limit.
+	// Exact counts the connections of all the clients together, and Distinct
+	// counts the connections of each client IP address separately.
+	// Defaults to Exact.
+	//
+	// +optional
+	// +kubebuilder:validation:Enum=Exact;Distinct
+	SourceIP *SourceMatchType `json:"sourceIP,omitempty"`
+	// Limit holds the number of connections allowed per unit of time.
+	Limit RateLimitValue `json:"limit"`
+}
+
+// TCPLocalRateLimit defines a local rate limit of the new connections.
+type TCPLocalRateLimit struct {
+	// Limit holds the number of connections allowed per unit of time,
+	// counted for all the clients together.
+	Limit RateLimitValue `json:"limit"`
+}
+
+// TCPAccessLogSettings defines when the access logs of the TCP connections
+// are written.
+type TCPAccessLogSettings struct {
+	// FlushInterval is the interval at which the access logs of the
+	// connections are written while they are open, to report long-lived
+	// connections before they are closed. It must be at least 1ms.
+	//
+	// +optional
+	// +kubebuilder:validation:Format=duration
+	FlushInterval *metav1.Duration `json:"flushInterval,omitempty"`
+	// FlushOnConnected writes an access log entry as soon as the connection
+	// to the backend is established.
+	//
+	// +optional
+	FlushOnConnected *bool `json:"flushOnConnected,omitempty"`
+}
+
+// UDPProxySettings defines the settings of a UDP proxy.
+type UDPProxySettings struct {
+	// SessionIdleTimeout is the time after which a UDP session is closed
+	// when no datagram has been received or sent.
+	// If unset, sessions are closed after one minute of inactivity.
+	//
+	// +optional
+	// +kubebuilder:validation:Format=duration
+	SessionIdleTimeout *metav1.Duration `json:"sessionIdleTimeout,omitempty"`
+}
+
+// L4TrafficPolicyStatus defines the state of L4TrafficPolicy
+type L4TrafficPolicyStatus struct {
+	// Conditions describe the current conditions of the L4TrafficPolicy.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=8
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// L4TrafficPolicyList contains a list of L4TrafficPolicy resources.
+type L4TrafficPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []L4TrafficPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&L4TrafficPolicy{}, &L4TrafficPolicyList{})
+}
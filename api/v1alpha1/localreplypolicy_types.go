@@ -0,0 +1,160 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwapiv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+const (
+	// KindLocalReplyPolicy is the name of the LocalReplyPolicy kind.
+	KindLocalReplyPolicy = "LocalReplyPolicy"
+
+	// LocalReplyBodyConfigMapKey is the key within the ConfigMap referenced
+	// by a LocalReplyBody that holds the body of the response.
+	LocalReplyBodyConfigMapKey = "response.body"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.conditions[?(@.type=="Accepted")].reason`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// LocalReplyPolicy allows the user to customize the responses that are
+// generated locally by Envoy, such as 404 (no route), 503 (no healthy
+// upstream) or 429 (rate limited), for all HTTP listeners of a Gateway.
+type LocalReplyPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of LocalReplyPolicy.
+	Spec LocalReplyPolicySpec `json:"spec"`
+
+	// Status defines the current status of LocalReplyPolicy.
+	Status LocalReplyPolicyStatus `json:"status,omitempty"`
+}
+
+// LocalReplyPolicySpec defines the desired state of LocalReplyPolicy.
+type LocalReplyPolicySpec struct {
+	// TargetRef is the name of the Gateway API resource this policy
+	// is being attached to.
+	// Currently only attaching to Gateway is supported
+	// This Policy and the TargetRef MUST be in the same namespace
+	// for this Policy to have effect and be applied to the Gateway
+	// TargetRef
+	TargetRef gwapiv1a2.PolicyTargetReference `json:"targetRef"`
+	// Mappers is the list of rules used to rewrite the local replies.
+	// The mappers are evaluated in order and the first one that matches
+	// a local reply is applied to it.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	Mappers []LocalReplyMapper `json:"mappers"`
+}
+
+// LocalReplyMapper defines how a matching local reply is rewritten.
+type LocalReplyMapper struct {
+	// Match selects the local replies this mapper applies to.
+	Match LocalReplyMatch `json:"match"`
+	// StatusCode overrides the status code of the matching local reply.
+	// If unset, the original status code is kept.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=200
+	// +kubebuilder:validation:Maximum=599
+	StatusCode *int32 `json:"statusCode,omitempty"`
+	// ContentType is the value of the Content-Type header of the
+	// rewritten reply. If unset, "text/plain" is used.
+	//
+	// +optional
+	ContentType *string `json:"contentType,omitempty"`
+	// Body overrides the body of the matching local reply.
+	// If unset, the original body is kept.
+	//
+	// +optional
+	Body *LocalReplyBody `json:"body,omitempty"`
+}
+
+// LocalReplyMatch defines the attributes of a local reply used to select it.
+// All the specified attributes must match for the mapper to be applied.
+type LocalReplyMatch struct {
+	// StatusCodes is the list of status codes to match on.
+	// The local reply matches if its status code is any of these.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	StatusCodes []LocalReplyStatusCode `json:"statusCodes"`
+	// ResponseFlags is an optional list of Envoy response flags to match on,
+	// e.g. "NR" (no route), "UH" (no healthy upstream) or "RL" (rate limited).
+	// The local reply matches if any of these flags is set.
+	// Refer to https://www.envoyproxy.io/docs/envoy/latest/configuration/observability/access_log/usage#config-access-log-format-response-flags
+	// for the list of response flags.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	ResponseFlags []string `json:"responseFlags,omitempty"`
+}
+
+// LocalReplyStatusCode is an HTTP response status code.
+// +kubebuilder:validation:Minimum=100
+// +kubebuilder:validation:Maximum=599
+type LocalReplyStatusCode int32
+
+// LocalReplyBodyType specifies where the body of a local reply is read from.
+// +kubebuilder:validation:Enum=Inline;ValueRef
+type LocalReplyBodyType string
+
+const (
+	// LocalReplyBodyTypeInline defines the body inline in the policy.
+	LocalReplyBodyTypeInline LocalReplyBodyType = "Inline"
+	// LocalReplyBodyTypeValueRef reads the body from a referenced ConfigMap.
+	LocalReplyBodyTypeValueRef LocalReplyBodyType = "ValueRef"
+)
+
+// LocalReplyBody defines the body of a rewritten local reply.
+// +union
+type LocalReplyBody struct {
+	// Type decides where the body is read from.
+	// Valid LocalReplyBodyType values are "Inline" and "ValueRef".
+	//
+	// +unionDiscriminator
+	Type LocalReplyBodyType `json:"type"`
+	// Inline is the body of the reply.
+	//
+	// +optional
+	Inline *string `json:"inline,omitempty"`
+	// ValueRef references a ConfigMap, in the same namespace as the policy,
+	// holding the body of the reply under the "response.body" key.
+	//
+	// +optional
+	ValueRef *gwapiv1b1.LocalObjectReference `json:"valueRef,omitempty"`
+}
+
+// LocalReplyPolicyStatus defines the state of LocalReplyPolicy
+type LocalReplyPolicyStatus struct {
+	// Conditions describe the current conditions of the LocalReplyPolicy.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=8
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// LocalReplyPolicyList contains a list of LocalReplyPolicy resources.
+type LocalReplyPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LocalReplyPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&LocalReplyPolicy{}, &LocalReplyPolicyList{})
+}
@@ -7,6 +7,7 @@ package v1alpha1
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 )
 
 const (
@@ -31,7 +32,7 @@ type RateLimitFilter struct {
 // +union
 type RateLimitFilterSpec struct {
 	// Type decides the scope for the RateLimits.
-	// Valid RateLimitType values are "Global".
+	// Valid RateLimitType values are "Global" or "Local".
 	//
 	// +unionDiscriminator
 	Type RateLimitType `json:"type"`
@@ -39,15 +40,21 @@ type RateLimitFilterSpec struct {
 	//
 	// +optional
 	Global *GlobalRateLimit `json:"global,omitempty"`
+	// Local defines local rate limit configuration.
+	//
+	// +optional
+	Local *LocalRateLimit `json:"local,omitempty"`
 }
 
 // RateLimitType specifies the types of RateLimiting.
-// +kubebuilder:validation:Enum=Global
+// +kubebuilder:validation:Enum=Global;Local
 type RateLimitType string
 
 const (
 	// GlobalRateLimitType allows the rate limits to be applied across all Envoy proxy instances.
 	GlobalRateLimitType RateLimitType = "Global"
+	// LocalRateLimitType allows the rate limits to be applied on a per Envoy proxy instance basis.
+	LocalRateLimitType RateLimitType = "Local"
 )
 
 // GlobalRateLimit defines global rate limit configuration.
@@ -64,6 +71,22 @@ type GlobalRateLimit struct {
 	Rules []RateLimitRule `json:"rules"`
 }
 
+// LocalRateLimit defines local rate limit configuration.
+type LocalRateLimit struct {
+	// Rules are a list of RateLimit selectors and limits.
+	// The limits are enforced by each Envoy proxy instance
+	// with a token bucket, without an external rate limit
+	// service, so the effective limit of a route is the
+	// limit multiplied by the number of Envoy proxy instances.
+	// If multiple rules get selected, each of their associated
+	// limits get applied.
+	// The Distinct selectors are not supported by local
+	// rate limits.
+	//
+	// +kubebuilder:validation:MaxItems=16
+	Rules []RateLimitRule `json:"rules"`
+}
+
 // RateLimitRule defines the semantics for matching attributes
 // from the incoming requests, and setting limits for them.
 type RateLimitRule struct {
@@ -84,6 +107,29 @@ type RateLimitRule struct {
 	// 429 HTTP status code is sent back to the client when
 	// the selected requests have reached the limit.
 	Limit RateLimitValue `json:"limit"`
+	// Cost is the number of hits each selected request counts as towards
+	// the limit, e.g. to count the requests to an expensive endpoint as 5
+	// requests. Defaults to 1.
+	// Envoy doesn't support counting a request as several hits, so the cost
+	// is applied by dividing the limit by the cost instead, e.g. a limit of
+	// 10 requests with a cost of 5 allows 2 selected requests per unit. The
+	// counters of the rules are never shared, so both allow the same requests.
+	// As a result, the number of requests of the limit must be divisible by
+	// the cost, otherwise the route is not accepted, and the X-RateLimit-Limit
+	// and X-RateLimit-Remaining response headers report the divided limit,
+	// i.e. requests rather than hits.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Cost *uint32 `json:"cost,omitempty"`
+	// ShadowMode indicates whether the limit is enforced. In shadow mode,
+	// the selected requests are counted towards the limit, and the requests
+	// over the limit are reported in the stats and logs of the rate limit
+	// service, but they are not rate limited. It allows to roll out new
+	// limits safely. Shadow mode is only supported by global rate limits.
+	//
+	// +optional
+	ShadowMode *bool `json:"shadowMode,omitempty"`
 }
 
 // RateLimitSelectCondition specifies the attributes within the traffic flow that can
@@ -103,6 +149,43 @@ type RateLimitSelectCondition struct {
 	//
 	// +optional
 	SourceCIDR *SourceMatch `json:"sourceCIDR,omitempty"`
+
+	// Path is the request path to match, e.g. to limit a path prefix
+	// within a route. The query string of the request is ignored.
+	//
+	// +optional
+	Path *gwapiv1b1.HTTPPathMatch `json:"path,omitempty"`
+
+	// Methods is a list of HTTP methods to match. The request must
+	// use one of the methods.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=9
+	Methods []gwapiv1b1.HTTPMethod `json:"methods,omitempty"`
+
+	// QueryParams is a list of query parameters to match. Multiple query
+	// parameter values are ANDed together, meaning, a request MUST match
+	// all the specified query parameters.
+	//
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	QueryParams []QueryParamMatch `json:"queryParams,omitempty"`
+
+	// JWTClaims is a list of claims of the JSON Web Token (JWT) of the
+	// request to match, e.g. to limit each authenticated user. The JWT
+	// must be validated by a JWT provider with an issuer of an
+	// AuthenticationFilter of the route, otherwise the route is not
+	// accepted, and the claims must be top level claims with string values.
+	// Multiple claims are ANDed together, meaning, a request MUST match all
+	// the specified claims.
+	//
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	JWTClaims []JWTClaimMatch `json:"jwtClaims,omitempty"`
 }
 
 type SourceMatchType string
@@ -152,6 +235,68 @@ type HeaderMatch struct {
 	Value *string `json:"value,omitempty"`
 }
 
+// QueryParamMatch defines the match attributes within the query parameters of the request.
+type QueryParamMatch struct {
+	// Type specifies how to match against the value of the query parameter.
+	//
+	// +optional
+	// +kubebuilder:default=Exact
+	Type *HeaderMatchType `json:"type,omitempty"`
+
+	// Name of the query parameter.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	Name string `json:"name"`
+
+	// Value of the query parameter.
+	// Do not set this field when Type="Distinct", implying matching on any/all unique
+	// values of the query parameter.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=1024
+	Value *string `json:"value,omitempty"`
+}
+
+// JWTClaimMatch defines the match attributes within the claims of the JWT of the request.
+type JWTClaimMatch struct {
+	// Type specifies how to match against the value of the claim.
+	//
+	// +optional
+	// +kubebuilder:default=Exact
+	Type *JWTClaimMatchType `json:"type,omitempty"`
+
+	// Name of the claim.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	Name string `json:"name"`
+
+	// Value of the claim.
+	// Do not set this field when Type="Distinct", implying matching on any/all unique
+	// values of the claim.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=1024
+	Value *string `json:"value,omitempty"`
+}
+
+// JWTClaimMatchType specifies the semantics of how JWT claim values should be compared.
+// Valid JWTClaimMatchType values are "Exact" and "Distinct".
+//
+// +kubebuilder:validation:Enum=Exact;Distinct
+type JWTClaimMatchType string
+
+// JWTClaimMatchType constants.
+const (
+	// JWTClaimMatchExact matches the exact value of the Value field against the value of
+	// the specified claim.
+	JWTClaimMatchExact JWTClaimMatchType = "Exact"
+	// JWTClaimMatchDistinct matches any and all possible unique values of the specified
+	// claim. Note that each unique value will receive its own rate limit bucket.
+	JWTClaimMatchDistinct JWTClaimMatchType = "Distinct"
+)
+
 // HeaderMatchType specifies the semantics of how HTTP header values should be compared.
 // Valid HeaderMatchType values are "Exact", "RegularExpression", and "Distinct".
 //
@@ -177,6 +322,16 @@ const (
 type RateLimitValue struct {
 	Requests uint          `json:"requests"`
 	Unit     RateLimitUnit `json:"unit"`
+	// UnitMultiplier multiplies the unit of the limit, e.g. a limit of 10000
+	// requests per Minute with a multiplier of 15 allows 10000 requests per
+	// 15 minutes. Multipliers other than 1 are only supported by local rate
+	// limits, since the rate limit service used by the global rate limits
+	// only supports the units themselves.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=1000
+	UnitMultiplier *uint32 `json:"unitMultiplier,omitempty"`
 }
 
 // RateLimitUnit specifies the intervals for setting rate limits.
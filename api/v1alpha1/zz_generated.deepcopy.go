@@ -11,30 +11,33 @@
 package v1alpha1
 
 import (
+	configv1alpha1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AuthenticationFilter) DeepCopyInto(out *AuthenticationFilter) {
+func (in *AccessLogPolicy) DeepCopyInto(out *AccessLogPolicy) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationFilter.
-func (in *AuthenticationFilter) DeepCopy() *AuthenticationFilter {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessLogPolicy.
+func (in *AccessLogPolicy) DeepCopy() *AccessLogPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(AuthenticationFilter)
+	out := new(AccessLogPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AuthenticationFilter) DeepCopyObject() runtime.Object {
+func (in *AccessLogPolicy) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -42,31 +45,31 @@ func (in *AuthenticationFilter) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AuthenticationFilterList) DeepCopyInto(out *AuthenticationFilterList) {
+func (in *AccessLogPolicyList) DeepCopyInto(out *AccessLogPolicyList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]AuthenticationFilter, len(*in))
+		*out = make([]AccessLogPolicy, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationFilterList.
-func (in *AuthenticationFilterList) DeepCopy() *AuthenticationFilterList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessLogPolicyList.
+func (in *AccessLogPolicyList) DeepCopy() *AccessLogPolicyList {
 	if in == nil {
 		return nil
 	}
-	out := new(AuthenticationFilterList)
+	out := new(AccessLogPolicyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AuthenticationFilterList) DeepCopyObject() runtime.Object {
+func (in *AccessLogPolicyList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -74,79 +77,91 @@ func (in *AuthenticationFilterList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AuthenticationFilterSpec) DeepCopyInto(out *AuthenticationFilterSpec) {
+func (in *AccessLogPolicySpec) DeepCopyInto(out *AccessLogPolicySpec) {
 	*out = *in
-	if in.JwtProviders != nil {
-		in, out := &in.JwtProviders, &out.JwtProviders
-		*out = make([]JwtAuthenticationFilterProvider, len(*in))
+	in.TargetRef.DeepCopyInto(&out.TargetRef)
+	if in.Settings != nil {
+		in, out := &in.Settings, &out.Settings
+		*out = make([]configv1alpha1.ProxyAccessLogSetting, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationFilterSpec.
-func (in *AuthenticationFilterSpec) DeepCopy() *AuthenticationFilterSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessLogPolicySpec.
+func (in *AccessLogPolicySpec) DeepCopy() *AccessLogPolicySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(AuthenticationFilterSpec)
+	out := new(AccessLogPolicySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClaimToHeader) DeepCopyInto(out *ClaimToHeader) {
+func (in *AccessLogPolicyStatus) DeepCopyInto(out *AccessLogPolicyStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClaimToHeader.
-func (in *ClaimToHeader) DeepCopy() *ClaimToHeader {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessLogPolicyStatus.
+func (in *AccessLogPolicyStatus) DeepCopy() *AccessLogPolicyStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ClaimToHeader)
+	out := new(AccessLogPolicyStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EnvoyJSONPatchConfig) DeepCopyInto(out *EnvoyJSONPatchConfig) {
+func (in *AccessLogPolicyTargetReference) DeepCopyInto(out *AccessLogPolicyTargetReference) {
 	*out = *in
-	in.Operation.DeepCopyInto(&out.Operation)
+	in.PolicyTargetReference.DeepCopyInto(&out.PolicyTargetReference)
+	if in.SectionName != nil {
+		in, out := &in.SectionName, &out.SectionName
+		*out = new(v1beta1.SectionName)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvoyJSONPatchConfig.
-func (in *EnvoyJSONPatchConfig) DeepCopy() *EnvoyJSONPatchConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessLogPolicyTargetReference.
+func (in *AccessLogPolicyTargetReference) DeepCopy() *AccessLogPolicyTargetReference {
 	if in == nil {
 		return nil
 	}
-	out := new(EnvoyJSONPatchConfig)
+	out := new(AccessLogPolicyTargetReference)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EnvoyPatchPolicy) DeepCopyInto(out *EnvoyPatchPolicy) {
+func (in *AuthenticationFilter) DeepCopyInto(out *AuthenticationFilter) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvoyPatchPolicy.
-func (in *EnvoyPatchPolicy) DeepCopy() *EnvoyPatchPolicy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationFilter.
+func (in *AuthenticationFilter) DeepCopy() *AuthenticationFilter {
 	if in == nil {
 		return nil
 	}
-	out := new(EnvoyPatchPolicy)
+	out := new(AuthenticationFilter)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *EnvoyPatchPolicy) DeepCopyObject() runtime.Object {
+func (in *AuthenticationFilter) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -154,31 +169,31 @@ func (in *EnvoyPatchPolicy) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EnvoyPatchPolicyList) DeepCopyInto(out *EnvoyPatchPolicyList) {
+func (in *AuthenticationFilterList) DeepCopyInto(out *AuthenticationFilterList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]EnvoyPatchPolicy, len(*in))
+		*out = make([]AuthenticationFilter, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvoyPatchPolicyList.
-func (in *EnvoyPatchPolicyList) DeepCopy() *EnvoyPatchPolicyList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationFilterList.
+func (in *AuthenticationFilterList) DeepCopy() *AuthenticationFilterList {
 	if in == nil {
 		return nil
 	}
-	out := new(EnvoyPatchPolicyList)
+	out := new(AuthenticationFilterList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *EnvoyPatchPolicyList) DeepCopyObject() runtime.Object {
+func (in *AuthenticationFilterList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -186,159 +201,184 @@ func (in *EnvoyPatchPolicyList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EnvoyPatchPolicySpec) DeepCopyInto(out *EnvoyPatchPolicySpec) {
+func (in *AuthenticationFilterSpec) DeepCopyInto(out *AuthenticationFilterSpec) {
 	*out = *in
-	if in.JSONPatches != nil {
-		in, out := &in.JSONPatches, &out.JSONPatches
-		*out = make([]EnvoyJSONPatchConfig, len(*in))
+	if in.JwtProviders != nil {
+		in, out := &in.JwtProviders, &out.JwtProviders
+		*out = make([]JwtAuthenticationFilterProvider, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	in.TargetRef.DeepCopyInto(&out.TargetRef)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvoyPatchPolicySpec.
-func (in *EnvoyPatchPolicySpec) DeepCopy() *EnvoyPatchPolicySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationFilterSpec.
+func (in *AuthenticationFilterSpec) DeepCopy() *AuthenticationFilterSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(EnvoyPatchPolicySpec)
+	out := new(AuthenticationFilterSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EnvoyPatchPolicyStatus) DeepCopyInto(out *EnvoyPatchPolicyStatus) {
+func (in *Backend) DeepCopyInto(out *Backend) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Backend.
+func (in *Backend) DeepCopy() *Backend {
+	if in == nil {
+		return nil
 	}
+	out := new(Backend)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvoyPatchPolicyStatus.
-func (in *EnvoyPatchPolicyStatus) DeepCopy() *EnvoyPatchPolicyStatus {
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Backend) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackendEndpoint) DeepCopyInto(out *BackendEndpoint) {
+	*out = *in
+	if in.FQDN != nil {
+		in, out := &in.FQDN, &out.FQDN
+		*out = new(FQDNEndpoint)
+		**out = **in
+	}
+	if in.IP != nil {
+		in, out := &in.IP, &out.IP
+		*out = new(IPEndpoint)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendEndpoint.
+func (in *BackendEndpoint) DeepCopy() *BackendEndpoint {
 	if in == nil {
 		return nil
 	}
-	out := new(EnvoyPatchPolicyStatus)
+	out := new(BackendEndpoint)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GlobalRateLimit) DeepCopyInto(out *GlobalRateLimit) {
+func (in *BackendList) DeepCopyInto(out *BackendList) {
 	*out = *in
-	if in.Rules != nil {
-		in, out := &in.Rules, &out.Rules
-		*out = make([]RateLimitRule, len(*in))
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Backend, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalRateLimit.
-func (in *GlobalRateLimit) DeepCopy() *GlobalRateLimit {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendList.
+func (in *BackendList) DeepCopy() *BackendList {
 	if in == nil {
 		return nil
 	}
-	out := new(GlobalRateLimit)
+	out := new(BackendList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BackendList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HeaderMatch) DeepCopyInto(out *HeaderMatch) {
+func (in *BackendSpec) DeepCopyInto(out *BackendSpec) {
 	*out = *in
-	if in.Type != nil {
-		in, out := &in.Type, &out.Type
-		*out = new(HeaderMatchType)
-		**out = **in
-	}
-	if in.Value != nil {
-		in, out := &in.Value, &out.Value
-		*out = new(string)
-		**out = **in
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = make([]BackendEndpoint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HeaderMatch.
-func (in *HeaderMatch) DeepCopy() *HeaderMatch {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendSpec.
+func (in *BackendSpec) DeepCopy() *BackendSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(HeaderMatch)
+	out := new(BackendSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JSONPatchOperation) DeepCopyInto(out *JSONPatchOperation) {
+func (in *ClaimToHeader) DeepCopyInto(out *ClaimToHeader) {
 	*out = *in
-	in.Value.DeepCopyInto(&out.Value)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JSONPatchOperation.
-func (in *JSONPatchOperation) DeepCopy() *JSONPatchOperation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClaimToHeader.
+func (in *ClaimToHeader) DeepCopy() *ClaimToHeader {
 	if in == nil {
 		return nil
 	}
-	out := new(JSONPatchOperation)
+	out := new(ClaimToHeader)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JwtAuthenticationFilterProvider) DeepCopyInto(out *JwtAuthenticationFilterProvider) {
+func (in *EnvoyJSONPatchConfig) DeepCopyInto(out *EnvoyJSONPatchConfig) {
 	*out = *in
-	if in.Audiences != nil {
-		in, out := &in.Audiences, &out.Audiences
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	out.RemoteJWKS = in.RemoteJWKS
-	if in.ClaimToHeaders != nil {
-		in, out := &in.ClaimToHeaders, &out.ClaimToHeaders
-		*out = make([]ClaimToHeader, len(*in))
-		copy(*out, *in)
-	}
+	in.Operation.DeepCopyInto(&out.Operation)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JwtAuthenticationFilterProvider.
-func (in *JwtAuthenticationFilterProvider) DeepCopy() *JwtAuthenticationFilterProvider {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvoyJSONPatchConfig.
+func (in *EnvoyJSONPatchConfig) DeepCopy() *EnvoyJSONPatchConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(JwtAuthenticationFilterProvider)
+	out := new(EnvoyJSONPatchConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RateLimitFilter) DeepCopyInto(out *RateLimitFilter) {
+func (in *EnvoyPatchPolicy) DeepCopyInto(out *EnvoyPatchPolicy) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitFilter.
-func (in *RateLimitFilter) DeepCopy() *RateLimitFilter {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvoyPatchPolicy.
+func (in *EnvoyPatchPolicy) DeepCopy() *EnvoyPatchPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(RateLimitFilter)
+	out := new(EnvoyPatchPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RateLimitFilter) DeepCopyObject() runtime.Object {
+func (in *EnvoyPatchPolicy) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -346,31 +386,31 @@ func (in *RateLimitFilter) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RateLimitFilterList) DeepCopyInto(out *RateLimitFilterList) {
+func (in *EnvoyPatchPolicyList) DeepCopyInto(out *EnvoyPatchPolicyList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]RateLimitFilter, len(*in))
+		*out = make([]EnvoyPatchPolicy, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitFilterList.
-func (in *RateLimitFilterList) DeepCopy() *RateLimitFilterList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvoyPatchPolicyList.
+func (in *EnvoyPatchPolicyList) DeepCopy() *EnvoyPatchPolicyList {
 	if in == nil {
 		return nil
 	}
-	out := new(RateLimitFilterList)
+	out := new(EnvoyPatchPolicyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RateLimitFilterList) DeepCopyObject() runtime.Object {
+func (in *EnvoyPatchPolicyList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -378,97 +418,1135 @@ func (in *RateLimitFilterList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RateLimitFilterSpec) DeepCopyInto(out *RateLimitFilterSpec) {
+func (in *EnvoyPatchPolicySpec) DeepCopyInto(out *EnvoyPatchPolicySpec) {
 	*out = *in
-	if in.Global != nil {
-		in, out := &in.Global, &out.Global
-		*out = new(GlobalRateLimit)
-		(*in).DeepCopyInto(*out)
+	if in.JSONPatches != nil {
+		in, out := &in.JSONPatches, &out.JSONPatches
+		*out = make([]EnvoyJSONPatchConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
+	in.TargetRef.DeepCopyInto(&out.TargetRef)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitFilterSpec.
-func (in *RateLimitFilterSpec) DeepCopy() *RateLimitFilterSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvoyPatchPolicySpec.
+func (in *EnvoyPatchPolicySpec) DeepCopy() *EnvoyPatchPolicySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(RateLimitFilterSpec)
+	out := new(EnvoyPatchPolicySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RateLimitRule) DeepCopyInto(out *RateLimitRule) {
+func (in *EnvoyPatchPolicyStatus) DeepCopyInto(out *EnvoyPatchPolicyStatus) {
 	*out = *in
-	if in.ClientSelectors != nil {
-		in, out := &in.ClientSelectors, &out.ClientSelectors
-		*out = make([]RateLimitSelectCondition, len(*in))
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	out.Limit = in.Limit
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitRule.
-func (in *RateLimitRule) DeepCopy() *RateLimitRule {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvoyPatchPolicyStatus.
+func (in *EnvoyPatchPolicyStatus) DeepCopy() *EnvoyPatchPolicyStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(RateLimitRule)
+	out := new(EnvoyPatchPolicyStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RateLimitSelectCondition) DeepCopyInto(out *RateLimitSelectCondition) {
+func (in *FQDNEndpoint) DeepCopyInto(out *FQDNEndpoint) {
 	*out = *in
-	if in.Headers != nil {
-		in, out := &in.Headers, &out.Headers
-		*out = make([]HeaderMatch, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.SourceCIDR != nil {
-		in, out := &in.SourceCIDR, &out.SourceCIDR
-		*out = new(SourceMatch)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitSelectCondition.
-func (in *RateLimitSelectCondition) DeepCopy() *RateLimitSelectCondition {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FQDNEndpoint.
+func (in *FQDNEndpoint) DeepCopy() *FQDNEndpoint {
 	if in == nil {
 		return nil
 	}
-	out := new(RateLimitSelectCondition)
+	out := new(FQDNEndpoint)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RateLimitValue) DeepCopyInto(out *RateLimitValue) {
+func (in *GRPCJSONPrintOptions) DeepCopyInto(out *GRPCJSONPrintOptions) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitValue.
-func (in *RateLimitValue) DeepCopy() *RateLimitValue {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCJSONPrintOptions.
+func (in *GRPCJSONPrintOptions) DeepCopy() *GRPCJSONPrintOptions {
 	if in == nil {
 		return nil
 	}
-	out := new(RateLimitValue)
+	out := new(GRPCJSONPrintOptions)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RemoteJWKS) DeepCopyInto(out *RemoteJWKS) {
+func (in *GRPCJSONTranscoderPolicy) DeepCopyInto(out *GRPCJSONTranscoderPolicy) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteJWKS.
-func (in *RemoteJWKS) DeepCopy() *RemoteJWKS {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCJSONTranscoderPolicy.
+func (in *GRPCJSONTranscoderPolicy) DeepCopy() *GRPCJSONTranscoderPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCJSONTranscoderPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GRPCJSONTranscoderPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCJSONTranscoderPolicyList) DeepCopyInto(out *GRPCJSONTranscoderPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GRPCJSONTranscoderPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCJSONTranscoderPolicyList.
+func (in *GRPCJSONTranscoderPolicyList) DeepCopy() *GRPCJSONTranscoderPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCJSONTranscoderPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GRPCJSONTranscoderPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCJSONTranscoderPolicySpec) DeepCopyInto(out *GRPCJSONTranscoderPolicySpec) {
+	*out = *in
+	in.TargetRef.DeepCopyInto(&out.TargetRef)
+	out.DescriptorSetRef = in.DescriptorSetRef
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PrintOptions != nil {
+		in, out := &in.PrintOptions, &out.PrintOptions
+		*out = new(GRPCJSONPrintOptions)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCJSONTranscoderPolicySpec.
+func (in *GRPCJSONTranscoderPolicySpec) DeepCopy() *GRPCJSONTranscoderPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCJSONTranscoderPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCJSONTranscoderPolicyStatus) DeepCopyInto(out *GRPCJSONTranscoderPolicyStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCJSONTranscoderPolicyStatus.
+func (in *GRPCJSONTranscoderPolicyStatus) DeepCopy() *GRPCJSONTranscoderPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCJSONTranscoderPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalRateLimit) DeepCopyInto(out *GlobalRateLimit) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]RateLimitRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalRateLimit.
+func (in *GlobalRateLimit) DeepCopy() *GlobalRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPBackendFilter) DeepCopyInto(out *HTTPBackendFilter) {
+	*out = *in
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPBackendFilter.
+func (in *HTTPBackendFilter) DeepCopy() *HTTPBackendFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPBackendFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPHostnameModifier) DeepCopyInto(out *HTTPHostnameModifier) {
+	*out = *in
+	if in.Header != nil {
+		in, out := &in.Header, &out.Header
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPHostnameModifier.
+func (in *HTTPHostnameModifier) DeepCopy() *HTTPHostnameModifier {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPHostnameModifier)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPPathModifier) DeepCopyInto(out *HTTPPathModifier) {
+	*out = *in
+	if in.ReplaceRegexMatch != nil {
+		in, out := &in.ReplaceRegexMatch, &out.ReplaceRegexMatch
+		*out = new(ReplaceRegexMatch)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPPathModifier.
+func (in *HTTPPathModifier) DeepCopy() *HTTPPathModifier {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPPathModifier)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPProtocolsFilter) DeepCopyInto(out *HTTPProtocolsFilter) {
+	*out = *in
+	if in.DisableWebSocket != nil {
+		in, out := &in.DisableWebSocket, &out.DisableWebSocket
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DisableGRPCWeb != nil {
+		in, out := &in.DisableGRPCWeb, &out.DisableGRPCWeb
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DisableGRPCStats != nil {
+		in, out := &in.DisableGRPCStats, &out.DisableGRPCStats
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPProtocolsFilter.
+func (in *HTTPProtocolsFilter) DeepCopy() *HTTPProtocolsFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPProtocolsFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPRequestMirrorFilter) DeepCopyInto(out *HTTPRequestMirrorFilter) {
+	*out = *in
+	in.BackendRef.DeepCopyInto(&out.BackendRef)
+	if in.Percent != nil {
+		in, out := &in.Percent, &out.Percent
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRequestMirrorFilter.
+func (in *HTTPRequestMirrorFilter) DeepCopy() *HTTPRequestMirrorFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPRequestMirrorFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPRequestRedirectFilter) DeepCopyInto(out *HTTPRequestRedirectFilter) {
+	*out = *in
+	if in.StatusCode != nil {
+		in, out := &in.StatusCode, &out.StatusCode
+		*out = new(int)
+		**out = **in
+	}
+	if in.StripQuery != nil {
+		in, out := &in.StripQuery, &out.StripQuery
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(HTTPPathModifier)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRequestRedirectFilter.
+func (in *HTTPRequestRedirectFilter) DeepCopy() *HTTPRequestRedirectFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPRequestRedirectFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPRouteFilter) DeepCopyInto(out *HTTPRouteFilter) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRouteFilter.
+func (in *HTTPRouteFilter) DeepCopy() *HTTPRouteFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPRouteFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HTTPRouteFilter) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPRouteFilterList) DeepCopyInto(out *HTTPRouteFilterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]HTTPRouteFilter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRouteFilterList.
+func (in *HTTPRouteFilterList) DeepCopy() *HTTPRouteFilterList {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPRouteFilterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HTTPRouteFilterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPRouteFilterSpec) DeepCopyInto(out *HTTPRouteFilterSpec) {
+	*out = *in
+	if in.RequestRedirect != nil {
+		in, out := &in.RequestRedirect, &out.RequestRedirect
+		*out = new(HTTPRequestRedirectFilter)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.URLRewrite != nil {
+		in, out := &in.URLRewrite, &out.URLRewrite
+		*out = new(HTTPURLRewriteFilter)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RequestMirror != nil {
+		in, out := &in.RequestMirror, &out.RequestMirror
+		*out = new(HTTPRequestMirrorFilter)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Protocols != nil {
+		in, out := &in.Protocols, &out.Protocols
+		*out = new(HTTPProtocolsFilter)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Backend != nil {
+		in, out := &in.Backend, &out.Backend
+		*out = new(HTTPBackendFilter)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRouteFilterSpec.
+func (in *HTTPRouteFilterSpec) DeepCopy() *HTTPRouteFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPRouteFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPURLRewriteFilter) DeepCopyInto(out *HTTPURLRewriteFilter) {
+	*out = *in
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(HTTPPathModifier)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Hostname != nil {
+		in, out := &in.Hostname, &out.Hostname
+		*out = new(HTTPHostnameModifier)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPURLRewriteFilter.
+func (in *HTTPURLRewriteFilter) DeepCopy() *HTTPURLRewriteFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPURLRewriteFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HeaderMatch) DeepCopyInto(out *HeaderMatch) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(HeaderMatchType)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HeaderMatch.
+func (in *HeaderMatch) DeepCopy() *HeaderMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(HeaderMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPEndpoint) DeepCopyInto(out *IPEndpoint) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPEndpoint.
+func (in *IPEndpoint) DeepCopy() *IPEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(IPEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JSONPatchOperation) DeepCopyInto(out *JSONPatchOperation) {
+	*out = *in
+	in.Value.DeepCopyInto(&out.Value)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JSONPatchOperation.
+func (in *JSONPatchOperation) DeepCopy() *JSONPatchOperation {
+	if in == nil {
+		return nil
+	}
+	out := new(JSONPatchOperation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWTClaimMatch) DeepCopyInto(out *JWTClaimMatch) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(JWTClaimMatchType)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTClaimMatch.
+func (in *JWTClaimMatch) DeepCopy() *JWTClaimMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTClaimMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JwtAuthenticationFilterProvider) DeepCopyInto(out *JwtAuthenticationFilterProvider) {
+	*out = *in
+	if in.Audiences != nil {
+		in, out := &in.Audiences, &out.Audiences
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.RemoteJWKS = in.RemoteJWKS
+	if in.ClaimToHeaders != nil {
+		in, out := &in.ClaimToHeaders, &out.ClaimToHeaders
+		*out = make([]ClaimToHeader, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JwtAuthenticationFilterProvider.
+func (in *JwtAuthenticationFilterProvider) DeepCopy() *JwtAuthenticationFilterProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(JwtAuthenticationFilterProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *L4TrafficPolicy) DeepCopyInto(out *L4TrafficPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new L4TrafficPolicy.
+func (in *L4TrafficPolicy) DeepCopy() *L4TrafficPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(L4TrafficPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *L4TrafficPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *L4TrafficPolicyList) DeepCopyInto(out *L4TrafficPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]L4TrafficPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new L4TrafficPolicyList.
+func (in *L4TrafficPolicyList) DeepCopy() *L4TrafficPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(L4TrafficPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *L4TrafficPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *L4TrafficPolicySpec) DeepCopyInto(out *L4TrafficPolicySpec) {
+	*out = *in
+	in.TargetRef.DeepCopyInto(&out.TargetRef)
+	if in.TCP != nil {
+		in, out := &in.TCP, &out.TCP
+		*out = new(TCPProxySettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UDP != nil {
+		in, out := &in.UDP, &out.UDP
+		*out = new(UDPProxySettings)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new L4TrafficPolicySpec.
+func (in *L4TrafficPolicySpec) DeepCopy() *L4TrafficPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(L4TrafficPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *L4TrafficPolicyStatus) DeepCopyInto(out *L4TrafficPolicyStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new L4TrafficPolicyStatus.
+func (in *L4TrafficPolicyStatus) DeepCopy() *L4TrafficPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(L4TrafficPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *L4TrafficPolicyTargetReference) DeepCopyInto(out *L4TrafficPolicyTargetReference) {
+	*out = *in
+	in.PolicyTargetReference.DeepCopyInto(&out.PolicyTargetReference)
+	if in.SectionName != nil {
+		in, out := &in.SectionName, &out.SectionName
+		*out = new(v1beta1.SectionName)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new L4TrafficPolicyTargetReference.
+func (in *L4TrafficPolicyTargetReference) DeepCopy() *L4TrafficPolicyTargetReference {
+	if in == nil {
+		return nil
+	}
+	out := new(L4TrafficPolicyTargetReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalRateLimit) DeepCopyInto(out *LocalRateLimit) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]RateLimitRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalRateLimit.
+func (in *LocalRateLimit) DeepCopy() *LocalRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalReplyBody) DeepCopyInto(out *LocalReplyBody) {
+	*out = *in
+	if in.Inline != nil {
+		in, out := &in.Inline, &out.Inline
+		*out = new(string)
+		**out = **in
+	}
+	if in.ValueRef != nil {
+		in, out := &in.ValueRef, &out.ValueRef
+		*out = new(v1beta1.LocalObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalReplyBody.
+func (in *LocalReplyBody) DeepCopy() *LocalReplyBody {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalReplyBody)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalReplyMapper) DeepCopyInto(out *LocalReplyMapper) {
+	*out = *in
+	in.Match.DeepCopyInto(&out.Match)
+	if in.StatusCode != nil {
+		in, out := &in.StatusCode, &out.StatusCode
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ContentType != nil {
+		in, out := &in.ContentType, &out.ContentType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Body != nil {
+		in, out := &in.Body, &out.Body
+		*out = new(LocalReplyBody)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalReplyMapper.
+func (in *LocalReplyMapper) DeepCopy() *LocalReplyMapper {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalReplyMapper)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalReplyMatch) DeepCopyInto(out *LocalReplyMatch) {
+	*out = *in
+	if in.StatusCodes != nil {
+		in, out := &in.StatusCodes, &out.StatusCodes
+		*out = make([]LocalReplyStatusCode, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResponseFlags != nil {
+		in, out := &in.ResponseFlags, &out.ResponseFlags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalReplyMatch.
+func (in *LocalReplyMatch) DeepCopy() *LocalReplyMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalReplyMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalReplyPolicy) DeepCopyInto(out *LocalReplyPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalReplyPolicy.
+func (in *LocalReplyPolicy) DeepCopy() *LocalReplyPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalReplyPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LocalReplyPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalReplyPolicyList) DeepCopyInto(out *LocalReplyPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LocalReplyPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalReplyPolicyList.
+func (in *LocalReplyPolicyList) DeepCopy() *LocalReplyPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalReplyPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LocalReplyPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalReplyPolicySpec) DeepCopyInto(out *LocalReplyPolicySpec) {
+	*out = *in
+	in.TargetRef.DeepCopyInto(&out.TargetRef)
+	if in.Mappers != nil {
+		in, out := &in.Mappers, &out.Mappers
+		*out = make([]LocalReplyMapper, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalReplyPolicySpec.
+func (in *LocalReplyPolicySpec) DeepCopy() *LocalReplyPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalReplyPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalReplyPolicyStatus) DeepCopyInto(out *LocalReplyPolicyStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalReplyPolicyStatus.
+func (in *LocalReplyPolicyStatus) DeepCopy() *LocalReplyPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalReplyPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueryParamMatch) DeepCopyInto(out *QueryParamMatch) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(HeaderMatchType)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueryParamMatch.
+func (in *QueryParamMatch) DeepCopy() *QueryParamMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(QueryParamMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitFilter) DeepCopyInto(out *RateLimitFilter) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitFilter.
+func (in *RateLimitFilter) DeepCopy() *RateLimitFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RateLimitFilter) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitFilterList) DeepCopyInto(out *RateLimitFilterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RateLimitFilter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitFilterList.
+func (in *RateLimitFilterList) DeepCopy() *RateLimitFilterList {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitFilterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RateLimitFilterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitFilterSpec) DeepCopyInto(out *RateLimitFilterSpec) {
+	*out = *in
+	if in.Global != nil {
+		in, out := &in.Global, &out.Global
+		*out = new(GlobalRateLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Local != nil {
+		in, out := &in.Local, &out.Local
+		*out = new(LocalRateLimit)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitFilterSpec.
+func (in *RateLimitFilterSpec) DeepCopy() *RateLimitFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitRule) DeepCopyInto(out *RateLimitRule) {
+	*out = *in
+	if in.ClientSelectors != nil {
+		in, out := &in.ClientSelectors, &out.ClientSelectors
+		*out = make([]RateLimitSelectCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.Limit.DeepCopyInto(&out.Limit)
+	if in.Cost != nil {
+		in, out := &in.Cost, &out.Cost
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.ShadowMode != nil {
+		in, out := &in.ShadowMode, &out.ShadowMode
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitRule.
+func (in *RateLimitRule) DeepCopy() *RateLimitRule {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitSelectCondition) DeepCopyInto(out *RateLimitSelectCondition) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]HeaderMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SourceCIDR != nil {
+		in, out := &in.SourceCIDR, &out.SourceCIDR
+		*out = new(SourceMatch)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(v1beta1.HTTPPathMatch)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Methods != nil {
+		in, out := &in.Methods, &out.Methods
+		*out = make([]v1beta1.HTTPMethod, len(*in))
+		copy(*out, *in)
+	}
+	if in.QueryParams != nil {
+		in, out := &in.QueryParams, &out.QueryParams
+		*out = make([]QueryParamMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.JWTClaims != nil {
+		in, out := &in.JWTClaims, &out.JWTClaims
+		*out = make([]JWTClaimMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitSelectCondition.
+func (in *RateLimitSelectCondition) DeepCopy() *RateLimitSelectCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitSelectCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitValue) DeepCopyInto(out *RateLimitValue) {
+	*out = *in
+	if in.UnitMultiplier != nil {
+		in, out := &in.UnitMultiplier, &out.UnitMultiplier
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitValue.
+func (in *RateLimitValue) DeepCopy() *RateLimitValue {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitValue)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteJWKS) DeepCopyInto(out *RemoteJWKS) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteJWKS.
+func (in *RemoteJWKS) DeepCopy() *RemoteJWKS {
 	if in == nil {
 		return nil
 	}
@@ -477,6 +1555,21 @@ func (in *RemoteJWKS) DeepCopy() *RemoteJWKS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplaceRegexMatch) DeepCopyInto(out *ReplaceRegexMatch) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplaceRegexMatch.
+func (in *ReplaceRegexMatch) DeepCopy() *ReplaceRegexMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplaceRegexMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SourceMatch) DeepCopyInto(out *SourceMatch) {
 	*out = *in
@@ -496,3 +1589,172 @@ func (in *SourceMatch) DeepCopy() *SourceMatch {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TCPAccessLogSettings) DeepCopyInto(out *TCPAccessLogSettings) {
+	*out = *in
+	if in.FlushInterval != nil {
+		in, out := &in.FlushInterval, &out.FlushInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.FlushOnConnected != nil {
+		in, out := &in.FlushOnConnected, &out.FlushOnConnected
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TCPAccessLogSettings.
+func (in *TCPAccessLogSettings) DeepCopy() *TCPAccessLogSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(TCPAccessLogSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TCPGlobalRateLimit) DeepCopyInto(out *TCPGlobalRateLimit) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]TCPRateLimitRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TCPGlobalRateLimit.
+func (in *TCPGlobalRateLimit) DeepCopy() *TCPGlobalRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(TCPGlobalRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TCPLocalRateLimit) DeepCopyInto(out *TCPLocalRateLimit) {
+	*out = *in
+	in.Limit.DeepCopyInto(&out.Limit)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TCPLocalRateLimit.
+func (in *TCPLocalRateLimit) DeepCopy() *TCPLocalRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(TCPLocalRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TCPProxySettings) DeepCopyInto(out *TCPProxySettings) {
+	*out = *in
+	if in.IdleTimeout != nil {
+		in, out := &in.IdleTimeout, &out.IdleTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxConnectionDuration != nil {
+		in, out := &in.MaxConnectionDuration, &out.MaxConnectionDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxConnectAttempts != nil {
+		in, out := &in.MaxConnectAttempts, &out.MaxConnectAttempts
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.AccessLog != nil {
+		in, out := &in.AccessLog, &out.AccessLog
+		*out = new(TCPAccessLogSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(TCPRateLimit)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TCPProxySettings.
+func (in *TCPProxySettings) DeepCopy() *TCPProxySettings {
+	if in == nil {
+		return nil
+	}
+	out := new(TCPProxySettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TCPRateLimit) DeepCopyInto(out *TCPRateLimit) {
+	*out = *in
+	if in.Global != nil {
+		in, out := &in.Global, &out.Global
+		*out = new(TCPGlobalRateLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Local != nil {
+		in, out := &in.Local, &out.Local
+		*out = new(TCPLocalRateLimit)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TCPRateLimit.
+func (in *TCPRateLimit) DeepCopy() *TCPRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(TCPRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TCPRateLimitRule) DeepCopyInto(out *TCPRateLimitRule) {
+	*out = *in
+	if in.SourceIP != nil {
+		in, out := &in.SourceIP, &out.SourceIP
+		*out = new(SourceMatchType)
+		**out = **in
+	}
+	in.Limit.DeepCopyInto(&out.Limit)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TCPRateLimitRule.
+func (in *TCPRateLimitRule) DeepCopy() *TCPRateLimitRule {
+	if in == nil {
+		return nil
+	}
+	out := new(TCPRateLimitRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UDPProxySettings) DeepCopyInto(out *UDPProxySettings) {
+	*out = *in
+	if in.SessionIdleTimeout != nil {
+		in, out := &in.SessionIdleTimeout, &out.SessionIdleTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UDPProxySettings.
+func (in *UDPProxySettings) DeepCopy() *UDPProxySettings {
+	if in == nil {
+		return nil
+	}
+	out := new(UDPProxySettings)
+	in.DeepCopyInto(out)
+	return out
+}
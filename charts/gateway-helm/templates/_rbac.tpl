@@ -30,6 +30,7 @@ Namespaced
 apiGroups:
 - ""
 resources:
+- configmaps
 - secrets
 - services
 verbs:
@@ -77,8 +78,14 @@ verbs:
 apiGroups:
 - gateway.envoyproxy.io
 resources:
+- accesslogpolicies
 - authenticationfilters
+- backends
 - envoypatchpolicies
+- grpcjsontranscoderpolicies
+- httproutefilters
+- l4trafficpolicies
+- localreplypolicies
 - ratelimitfilters
 verbs:
 - get
@@ -92,7 +99,11 @@ verbs:
 apiGroups:
 - gateway.envoyproxy.io
 resources:
+- accesslogpolicies/status
 - envoypatchpolicies/status
+- grpcjsontranscoderpolicies/status
+- l4trafficpolicies/status
+- localreplypolicies/status
 verbs:
 - update
 {{- end }}
@@ -387,7 +387,7 @@ func constructConfigDump(resources *gatewayapi.Resources, tCtx *xds_types.Resour
 	// construct bootstrap config
 	var bootstrapConfigurations string
 	var err error
-	if bootstrapConfigurations, err = bootstrap.GetRenderedBootstrapConfig(nil); err != nil {
+	if bootstrapConfigurations, err = bootstrap.GetRenderedBootstrapConfig(nil, nil); err != nil {
 		return nil, err
 	}
 
@@ -765,6 +765,76 @@ func kubernetesYAMLToResources(str string, addMissingResources bool) (*gatewayap
 				Spec: typedSpec.(egv1a1.EnvoyPatchPolicySpec),
 			}
 			resources.EnvoyPatchPolicies = append(resources.EnvoyPatchPolicies, envoyPatchPolicy)
+		case egv1a1.KindLocalReplyPolicy:
+			typedSpec := spec.Interface()
+			localReplyPolicy := &egv1a1.LocalReplyPolicy{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       egv1a1.KindLocalReplyPolicy,
+					APIVersion: egv1a1.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      name,
+				},
+				Spec: typedSpec.(egv1a1.LocalReplyPolicySpec),
+			}
+			resources.LocalReplyPolicies = append(resources.LocalReplyPolicies, localReplyPolicy)
+		case egv1a1.KindL4TrafficPolicy:
+			typedSpec := spec.Interface()
+			l4TrafficPolicy := &egv1a1.L4TrafficPolicy{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       egv1a1.KindL4TrafficPolicy,
+					APIVersion: egv1a1.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      name,
+				},
+				Spec: typedSpec.(egv1a1.L4TrafficPolicySpec),
+			}
+			resources.L4TrafficPolicies = append(resources.L4TrafficPolicies, l4TrafficPolicy)
+		case egv1a1.KindGRPCJSONTranscoderPolicy:
+			typedSpec := spec.Interface()
+			grpcJSONTranscoderPolicy := &egv1a1.GRPCJSONTranscoderPolicy{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       egv1a1.KindGRPCJSONTranscoderPolicy,
+					APIVersion: egv1a1.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      name,
+				},
+				Spec: typedSpec.(egv1a1.GRPCJSONTranscoderPolicySpec),
+			}
+			resources.GRPCJSONTranscoderPolicies = append(resources.GRPCJSONTranscoderPolicies, grpcJSONTranscoderPolicy)
+		case egv1a1.KindAccessLogPolicy:
+			typedSpec := spec.Interface()
+			accessLogPolicy := &egv1a1.AccessLogPolicy{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       egv1a1.KindAccessLogPolicy,
+					APIVersion: egv1a1.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      name,
+				},
+				Spec: typedSpec.(egv1a1.AccessLogPolicySpec),
+			}
+			resources.AccessLogPolicies = append(resources.AccessLogPolicies, accessLogPolicy)
+		case egv1a1.KindBackend:
+			typedSpec := spec.Interface()
+			backend := &egv1a1.Backend{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       egv1a1.KindBackend,
+					APIVersion: egv1a1.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      name,
+				},
+				Spec: typedSpec.(egv1a1.BackendSpec),
+			}
+			resources.Backends = append(resources.Backends, backend)
 		case egv1a1.KindRateLimitFilter:
 			typedSpec := spec.Interface()
 			rateLimitFilter := &egv1a1.RateLimitFilter{
@@ -779,6 +849,20 @@ func kubernetesYAMLToResources(str string, addMissingResources bool) (*gatewayap
 				Spec: typedSpec.(egv1a1.RateLimitFilterSpec),
 			}
 			resources.RateLimitFilters = append(resources.RateLimitFilters, rateLimitFilter)
+		case egv1a1.KindHTTPRouteFilter:
+			typedSpec := spec.Interface()
+			httpRouteFilter := &egv1a1.HTTPRouteFilter{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       egv1a1.KindHTTPRouteFilter,
+					APIVersion: egv1a1.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      name,
+				},
+				Spec: typedSpec.(egv1a1.HTTPRouteFilterSpec),
+			}
+			resources.HTTPRouteFilters = append(resources.HTTPRouteFilters, httpRouteFilter)
 		}
 	}
 
@@ -872,7 +956,7 @@ func addDefaultEnvoyProxy(resources *gatewayapi.Resources) error {
 
 	defaultEnvoyProxyName := "default-envoy-proxy"
 	namespace := resources.GatewayClass.Namespace
-	defaultBootstrapStr, err := bootstrap.GetRenderedBootstrapConfig(nil)
+	defaultBootstrapStr, err := bootstrap.GetRenderedBootstrapConfig(nil, nil)
 	if err != nil {
 		return err
 	}
@@ -8,6 +8,7 @@ package config
 import (
 	"errors"
 	"fmt"
+	"net"
 	"net/url"
 
 	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
@@ -90,15 +91,7 @@ func (s *Server) Validate() error {
 			}
 		}
 	case s.EnvoyGateway.RateLimit != nil:
-		if s.EnvoyGateway.RateLimit.Backend.Type != v1alpha1.RedisBackendType {
-			return fmt.Errorf("unsupported ratelimit backend %v", s.EnvoyGateway.RateLimit.Backend.Type)
-		}
-		if s.EnvoyGateway.RateLimit.Backend.Redis == nil || s.EnvoyGateway.RateLimit.Backend.Redis.URL == "" {
-			return fmt.Errorf("empty ratelimit redis settings")
-		}
-		if _, err := url.Parse(s.EnvoyGateway.RateLimit.Backend.Redis.URL); err != nil {
-			return fmt.Errorf("unknown ratelimit redis url format: %w", err)
-		}
+		return validateRateLimitBackend(&s.EnvoyGateway.RateLimit.Backend)
 	case s.EnvoyGateway.ExtensionManager != nil:
 		if s.EnvoyGateway.ExtensionManager.Hooks == nil || s.EnvoyGateway.ExtensionManager.Hooks.XDSTranslator == nil {
 			return fmt.Errorf("registered extension has no hooks specified")
@@ -126,3 +119,74 @@ func (s *Server) Validate() error {
 	}
 	return nil
 }
+
+// validateRateLimitBackend validates the database backend of the rate limit service,
+// so that invalid settings are reported before deploying the rate limit service.
+func validateRateLimitBackend(backend *v1alpha1.RateLimitDatabaseBackend) error {
+	switch backend.Type {
+	case v1alpha1.RedisBackendType:
+		return validateRateLimitRedis(backend.Redis)
+	case v1alpha1.MemcachedBackendType:
+		if backend.Memcached == nil || len(backend.Memcached.URLs) == 0 {
+			return fmt.Errorf("empty ratelimit memcached settings")
+		}
+		for _, u := range backend.Memcached.URLs {
+			if _, _, err := net.SplitHostPort(u); err != nil {
+				return fmt.Errorf("unknown ratelimit memcached url format: %w", err)
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported ratelimit backend %v", backend.Type)
+	}
+	return nil
+}
+
+// validateRateLimitRedis validates the redis settings of the rate limit service.
+func validateRateLimitRedis(redis *v1alpha1.RateLimitRedisSettings) error {
+	if redis == nil {
+		return fmt.Errorf("empty ratelimit redis settings")
+	}
+
+	redisType := v1alpha1.RedisTypeSingle
+	if redis.Type != nil {
+		redisType = *redis.Type
+	}
+
+	var urls []string
+	switch redisType {
+	case v1alpha1.RedisTypeSingle:
+		if redis.URL == "" {
+			return fmt.Errorf("empty ratelimit redis settings")
+		}
+		if _, err := url.Parse(redis.URL); err != nil {
+			return fmt.Errorf("unknown ratelimit redis url format: %w", err)
+		}
+	case v1alpha1.RedisTypeSentinel:
+		if redis.Sentinel == nil || len(redis.Sentinel.URLs) == 0 {
+			return fmt.Errorf("empty ratelimit redis sentinel settings")
+		}
+		if redis.Sentinel.MasterName == "" {
+			return fmt.Errorf("empty ratelimit redis sentinel master name")
+		}
+		urls = redis.Sentinel.URLs
+	case v1alpha1.RedisTypeCluster:
+		if redis.Cluster == nil || len(redis.Cluster.URLs) == 0 {
+			return fmt.Errorf("empty ratelimit redis cluster settings")
+		}
+		urls = redis.Cluster.URLs
+	default:
+		return fmt.Errorf("unsupported ratelimit redis type %v", redisType)
+	}
+
+	for _, u := range urls {
+		if _, _, err := net.SplitHostPort(u); err != nil {
+			return fmt.Errorf("unknown ratelimit redis url format: %w", err)
+		}
+	}
+
+	if redis.Auth != nil && redis.Auth.PasswordRef.Name == "" {
+		return fmt.Errorf("empty ratelimit redis auth passwordRef")
+	}
+
+	return nil
+}
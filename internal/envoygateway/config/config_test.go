@@ -18,6 +18,8 @@ import (
 var (
 	TLSSecretKind       = v1beta1.Kind("Secret")
 	TLSUnrecognizedKind = v1beta1.Kind("Unrecognized")
+	sentinelType        = v1alpha1.RedisTypeSentinel
+	clusterType         = v1alpha1.RedisTypeCluster
 )
 
 func TestValidate(t *testing.T) {
@@ -180,6 +182,169 @@ func TestValidate(t *testing.T) {
 			},
 			expect: true,
 		},
+		{
+			name: "happy ratelimit redis sentinel settings",
+			cfg: &Server{
+				EnvoyGateway: &v1alpha1.EnvoyGateway{
+					EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
+						Gateway:  v1alpha1.DefaultGateway(),
+						Provider: v1alpha1.DefaultEnvoyGatewayProvider(),
+						RateLimit: &v1alpha1.RateLimit{
+							Backend: v1alpha1.RateLimitDatabaseBackend{
+								Type: v1alpha1.RedisBackendType,
+								Redis: &v1alpha1.RateLimitRedisSettings{
+									Type: &sentinelType,
+									Sentinel: &v1alpha1.RedisSentinelSettings{
+										MasterName: "mymaster",
+										URLs:       []string{"sentinel-0:26379", "sentinel-1:26379"},
+									},
+									Auth: &v1alpha1.RedisAuth{
+										PasswordRef: v1beta1.SecretObjectReference{
+											Name: "redis-password",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				Namespace: "test-ns",
+			},
+			expect: true,
+		},
+		{
+			name: "empty ratelimit redis sentinel master name",
+			cfg: &Server{
+				EnvoyGateway: &v1alpha1.EnvoyGateway{
+					EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
+						Gateway:  v1alpha1.DefaultGateway(),
+						Provider: v1alpha1.DefaultEnvoyGatewayProvider(),
+						RateLimit: &v1alpha1.RateLimit{
+							Backend: v1alpha1.RateLimitDatabaseBackend{
+								Type: v1alpha1.RedisBackendType,
+								Redis: &v1alpha1.RateLimitRedisSettings{
+									Type: &sentinelType,
+									Sentinel: &v1alpha1.RedisSentinelSettings{
+										URLs: []string{"sentinel-0:26379"},
+									},
+								},
+							},
+						},
+					},
+				},
+				Namespace: "test-ns",
+			},
+			expect: false,
+		},
+		{
+			name: "happy ratelimit redis cluster settings",
+			cfg: &Server{
+				EnvoyGateway: &v1alpha1.EnvoyGateway{
+					EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
+						Gateway:  v1alpha1.DefaultGateway(),
+						Provider: v1alpha1.DefaultEnvoyGatewayProvider(),
+						RateLimit: &v1alpha1.RateLimit{
+							Backend: v1alpha1.RateLimitDatabaseBackend{
+								Type: v1alpha1.RedisBackendType,
+								Redis: &v1alpha1.RateLimitRedisSettings{
+									Type: &clusterType,
+									Cluster: &v1alpha1.RedisClusterSettings{
+										URLs: []string{"redis-0:6379", "redis-1:6379"},
+									},
+								},
+							},
+						},
+					},
+				},
+				Namespace: "test-ns",
+			},
+			expect: true,
+		},
+		{
+			name: "unknown ratelimit redis cluster url format",
+			cfg: &Server{
+				EnvoyGateway: &v1alpha1.EnvoyGateway{
+					EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
+						Gateway:  v1alpha1.DefaultGateway(),
+						Provider: v1alpha1.DefaultEnvoyGatewayProvider(),
+						RateLimit: &v1alpha1.RateLimit{
+							Backend: v1alpha1.RateLimitDatabaseBackend{
+								Type: v1alpha1.RedisBackendType,
+								Redis: &v1alpha1.RateLimitRedisSettings{
+									Type: &clusterType,
+									Cluster: &v1alpha1.RedisClusterSettings{
+										URLs: []string{"redis-0"},
+									},
+								},
+							},
+						},
+					},
+				},
+				Namespace: "test-ns",
+			},
+			expect: false,
+		},
+		{
+			name: "empty ratelimit redis auth passwordRef",
+			cfg: &Server{
+				EnvoyGateway: &v1alpha1.EnvoyGateway{
+					EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
+						Gateway:  v1alpha1.DefaultGateway(),
+						Provider: v1alpha1.DefaultEnvoyGatewayProvider(),
+						RateLimit: &v1alpha1.RateLimit{
+							Backend: v1alpha1.RateLimitDatabaseBackend{
+								Type: v1alpha1.RedisBackendType,
+								Redis: &v1alpha1.RateLimitRedisSettings{
+									URL:  "localhost:6376",
+									Auth: &v1alpha1.RedisAuth{},
+								},
+							},
+						},
+					},
+				},
+				Namespace: "test-ns",
+			},
+			expect: false,
+		},
+		{
+			name: "happy ratelimit memcached settings",
+			cfg: &Server{
+				EnvoyGateway: &v1alpha1.EnvoyGateway{
+					EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
+						Gateway:  v1alpha1.DefaultGateway(),
+						Provider: v1alpha1.DefaultEnvoyGatewayProvider(),
+						RateLimit: &v1alpha1.RateLimit{
+							Backend: v1alpha1.RateLimitDatabaseBackend{
+								Type: v1alpha1.MemcachedBackendType,
+								Memcached: &v1alpha1.RateLimitMemcachedSettings{
+									URLs: []string{"memcached-0:11211", "memcached-1:11211"},
+								},
+							},
+						},
+					},
+				},
+				Namespace: "test-ns",
+			},
+			expect: true,
+		},
+		{
+			name: "empty ratelimit memcached settings",
+			cfg: &Server{
+				EnvoyGateway: &v1alpha1.EnvoyGateway{
+					EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
+						Gateway:  v1alpha1.DefaultGateway(),
+						Provider: v1alpha1.DefaultEnvoyGatewayProvider(),
+						RateLimit: &v1alpha1.RateLimit{
+							Backend: v1alpha1.RateLimitDatabaseBackend{
+								Type: v1alpha1.MemcachedBackendType,
+							},
+						},
+					},
+				},
+				Namespace: "test-ns",
+			},
+			expect: false,
+		},
 		{
 			name: "happy extension settings",
 			cfg: &Server{
@@ -0,0 +1,300 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package gatewayapi
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	gwv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"github.com/envoyproxy/gateway/api/config/v1alpha1/validation"
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/status"
+)
+
+// ProcessAccessLogPolicies sets the access logs of the listeners of the xds IR. The listeners
+// use the access logs of the EnvoyProxy, unless they are overridden by the AccessLogPolicies.
+// The policies attached to a Gateway are applied first, then the policies attached to a listener
+// of a Gateway, so that the most specific policy takes precedence. The policies attached to an
+// HTTPRoute or a GRPCRoute disable the access logs of the requests on the route.
+func (t *Translator) ProcessAccessLogPolicies(accessLogPolicies []*egv1a1.AccessLogPolicy,
+	gateways []*GatewayContext,
+	httpRoutes []*HTTPRouteContext,
+	grpcRoutes []*GRPCRouteContext,
+	resources *Resources,
+	xdsIR XdsIRMap) []*egv1a1.AccessLogPolicy {
+	var res []*egv1a1.AccessLogPolicy
+
+	defaultAccessLog := processAccessLog(resources.EnvoyProxy)
+	for _, gateway := range gateways {
+		if gwXdsIR, ok := xdsIR[irStringKey(gateway.Namespace, gateway.Name)]; ok {
+			setListenersAccessLog(gwXdsIR, "", defaultAccessLog)
+		}
+	}
+
+	// Sort based on creation timestamp, the oldest policy wins when several
+	// policies target the same resource.
+	sort.Slice(accessLogPolicies, func(i, j int) bool {
+		if accessLogPolicies[i].CreationTimestamp.Equal(&(accessLogPolicies[j].CreationTimestamp)) {
+			return accessLogPolicies[i].Name < accessLogPolicies[j].Name
+		}
+		return accessLogPolicies[i].CreationTimestamp.Before(&(accessLogPolicies[j].CreationTimestamp))
+	})
+
+	gatewayMap := make(map[types.NamespacedName]*GatewayContext, len(gateways))
+	for _, gateway := range gateways {
+		gatewayMap[types.NamespacedName{Namespace: gateway.Namespace, Name: gateway.Name}] = gateway
+	}
+	httpRouteMap := make(map[types.NamespacedName]*HTTPRouteContext, len(httpRoutes))
+	for _, httpRoute := range httpRoutes {
+		httpRouteMap[types.NamespacedName{Namespace: httpRoute.Namespace, Name: httpRoute.Name}] = httpRoute
+	}
+	grpcRouteMap := make(map[types.NamespacedName]*GRPCRouteContext, len(grpcRoutes))
+	for _, grpcRoute := range grpcRoutes {
+		grpcRouteMap[types.NamespacedName{Namespace: grpcRoute.Namespace, Name: grpcRoute.Name}] = grpcRoute
+	}
+
+	// Policies are handled in three passes, Gateway targets first, then listener
+	// targets, then Route targets.
+	var gatewayPolicies, listenerPolicies, routePolicies []*egv1a1.AccessLogPolicy
+	for _, policy := range accessLogPolicies {
+		policy := policy.DeepCopy()
+		res = append(res, policy)
+
+		targetRef := policy.Spec.TargetRef
+		if targetRef.Group != gwv1b1.GroupName ||
+			(targetRef.Kind != KindGateway && targetRef.Kind != KindHTTPRoute && targetRef.Kind != KindGRPCRoute) {
+			message := fmt.Sprintf("TargetRef.Group:%s TargetRef.Kind:%s, only TargetRef.Group:%s and TargetRef.Kind:%s, %s or %s are supported.",
+				targetRef.Group, targetRef.Kind, gwv1b1.GroupName, KindGateway, KindHTTPRoute, KindGRPCRoute)
+
+			status.SetAccessLogPolicyCondition(policy,
+				gwv1a2.PolicyConditionAccepted,
+				metav1.ConditionFalse,
+				gwv1a2.PolicyReasonInvalid,
+				message,
+			)
+			continue
+		}
+
+		// Ensure Policy and target are in the same namespace
+		targetNs := NamespaceDerefOr(targetRef.Namespace, policy.Namespace)
+		if policy.Namespace != targetNs {
+			message := fmt.Sprintf("Namespace:%s TargetRef.Namespace:%s, AccessLogPolicy can only target a resource in the same namespace.",
+				policy.Namespace, targetNs)
+
+			status.SetAccessLogPolicyCondition(policy,
+				gwv1a2.PolicyConditionAccepted,
+				metav1.ConditionFalse,
+				gwv1a2.PolicyReasonInvalid,
+				message,
+			)
+			continue
+		}
+
+		if err := validateAccessLogPolicySpec(policy); err != nil {
+			status.SetAccessLogPolicyCondition(policy,
+				gwv1a2.PolicyConditionAccepted,
+				metav1.ConditionFalse,
+				gwv1a2.PolicyReasonInvalid,
+				err.Error(),
+			)
+			continue
+		}
+
+		switch {
+		case targetRef.Kind != KindGateway:
+			routePolicies = append(routePolicies, policy)
+		case targetRef.SectionName != nil:
+			listenerPolicies = append(listenerPolicies, policy)
+		default:
+			gatewayPolicies = append(gatewayPolicies, policy)
+		}
+	}
+
+	handledTargets := make(map[string]*egv1a1.AccessLogPolicy)
+	for _, policy := range append(append(gatewayPolicies, listenerPolicies...), routePolicies...) {
+		targetRef := policy.Spec.TargetRef
+		key := types.NamespacedName{Namespace: policy.Namespace, Name: string(targetRef.Name)}
+		target := fmt.Sprintf("%s/%s", targetRef.Kind, key)
+
+		var (
+			gateway   *GatewayContext
+			listener  *ListenerContext
+			irRoutes  []*ir.HTTPRoute
+			found     bool
+			targetMsg = fmt.Sprintf("%s:%s", targetRef.Kind, targetRef.Name)
+		)
+		switch targetRef.Kind {
+		case KindGateway:
+			gateway, found = gatewayMap[key]
+			if targetRef.SectionName != nil {
+				target = fmt.Sprintf("%s/%s", target, *targetRef.SectionName)
+				targetMsg = fmt.Sprintf("%s SectionName:%s", targetMsg, *targetRef.SectionName)
+				if found {
+					listener = getGatewayListener(gateway, *targetRef.SectionName)
+					found = listener != nil
+				}
+			}
+		case KindHTTPRoute:
+			var httpRoute *HTTPRouteContext
+			if httpRoute, found = httpRouteMap[key]; found {
+				irRoutes = getIRRoutes(httpRoute, httpRoute.ParentRefs, xdsIR)
+			}
+		case KindGRPCRoute:
+			var grpcRoute *GRPCRouteContext
+			if grpcRoute, found = grpcRouteMap[key]; found {
+				irRoutes = getIRRoutes(grpcRoute, grpcRoute.ParentRefs, xdsIR)
+			}
+		}
+
+		if !found {
+			message := fmt.Sprintf("%s not found.", targetMsg)
+
+			status.SetAccessLogPolicyCondition(policy,
+				gwv1a2.PolicyConditionAccepted,
+				metav1.ConditionFalse,
+				gwv1a2.PolicyReasonTargetNotFound,
+				message,
+			)
+			continue
+		}
+
+		// Ensure the target is not already targeted by another policy
+		if winner, ok := handledTargets[target]; ok {
+			message := fmt.Sprintf("%s is already targeted by AccessLogPolicy:%s.", targetMsg, winner.Name)
+
+			status.SetAccessLogPolicyCondition(policy,
+				gwv1a2.PolicyConditionAccepted,
+				metav1.ConditionFalse,
+				gwv1a2.PolicyReasonConflicted,
+				message,
+			)
+			continue
+		}
+
+		// The plain HTTP listeners sharing a port share the access logs of the
+		// connection manager of the port.
+		if listener != nil && listener.Protocol == gwv1b1.HTTPProtocolType {
+			if shared := getListenerSharingHTTPPort(gateway, listener); shared != nil {
+				message := fmt.Sprintf("%s shares its port with Listener:%s, the access logs of the HTTP listeners sharing a port can only be set for the whole Gateway.",
+					targetMsg, shared.Name)
+
+				status.SetAccessLogPolicyCondition(policy,
+					gwv1a2.PolicyConditionAccepted,
+					metav1.ConditionFalse,
+					gwv1a2.PolicyReasonInvalid,
+					message,
+				)
+				continue
+			}
+		}
+		handledTargets[target] = policy
+
+		if targetRef.Kind == KindGateway {
+			var accessLog *ir.AccessLog
+			if !policy.Spec.Disable {
+				accessLog = processAccessLogSettings(fmt.Sprintf("accesslogpolicy/%s/%s", policy.Namespace, policy.Name),
+					policy.Namespace, policy.Spec.Settings)
+			}
+			listenerName := ""
+			if listener != nil {
+				listenerName = irHTTPListenerName(listener)
+			}
+			if gwXdsIR, ok := xdsIR[irStringKey(gateway.Namespace, gateway.Name)]; ok {
+				setListenersAccessLog(gwXdsIR, listenerName, accessLog)
+			}
+		} else {
+			for _, irRoute := range irRoutes {
+				irRoute.DisableAccessLog = true
+			}
+		}
+
+		// Set Accepted=True
+		status.SetAccessLogPolicyCondition(policy,
+			gwv1a2.PolicyConditionAccepted,
+			metav1.ConditionTrue,
+			gwv1a2.PolicyReasonAccepted,
+			"AccessLogPolicy has been accepted.",
+		)
+	}
+
+	return res
+}
+
+// validateAccessLogPolicySpec validates the settings of the policy against its target.
+func validateAccessLogPolicySpec(policy *egv1a1.AccessLogPolicy) error {
+	spec := policy.Spec
+	if spec.TargetRef.SectionName != nil && spec.TargetRef.Kind != KindGateway {
+		return fmt.Errorf("targetRef.sectionName can only be set when targeting a %s", KindGateway)
+	}
+
+	if spec.Disable && len(spec.Settings) > 0 {
+		return fmt.Errorf("settings can't be set when disable is true")
+	}
+
+	if spec.TargetRef.Kind != KindGateway && !spec.Disable {
+		return fmt.Errorf("only disabling the access logs is supported when targeting a route")
+	}
+
+	if err := validation.ValidateProxyAccessLogSettings(spec.Settings); err != nil {
+		return fmt.Errorf("invalid settings: %w", err)
+	}
+
+	return nil
+}
+
+// getGatewayListener returns the listener of the Gateway with the given name.
+func getGatewayListener(gateway *GatewayContext, name gwv1b1.SectionName) *ListenerContext {
+	for _, listener := range gateway.listeners {
+		if listener.Name == name {
+			return listener
+		}
+	}
+	return nil
+}
+
+// getListenerSharingHTTPPort returns another plain HTTP listener of the Gateway
+// on the same port as the listener, if any.
+func getListenerSharingHTTPPort(gateway *GatewayContext, listener *ListenerContext) *ListenerContext {
+	for _, other := range gateway.listeners {
+		if other.Name != listener.Name && other.Protocol == gwv1b1.HTTPProtocolType && other.Port == listener.Port {
+			return other
+		}
+	}
+	return nil
+}
+
+// setListenersAccessLog sets the access log of the IR listeners created for the
+// listener of the Gateway with the given IR name, or of all the IR listeners if
+// the name is empty. The TCP and UDP IR listeners are named after the listener
+// of the Gateway followed by the name of their route.
+func setListenersAccessLog(gwXdsIR *ir.Xds, listenerName string, accessLog *ir.AccessLog) {
+	matches := func(name string) bool {
+		return listenerName == "" || name == listenerName || strings.HasPrefix(name, listenerName+"/")
+	}
+
+	for _, httpListener := range gwXdsIR.HTTP {
+		if matches(httpListener.Name) {
+			httpListener.AccessLog = accessLog
+		}
+	}
+	for _, tcpListener := range gwXdsIR.TCP {
+		if matches(tcpListener.Name) {
+			tcpListener.AccessLog = accessLog
+		}
+	}
+	for _, udpListener := range gwXdsIR.UDP {
+		if matches(udpListener.Name) {
+			udpListener.AccessLog = accessLog
+		}
+	}
+}
@@ -0,0 +1,197 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package gatewayapi
+
+import (
+	"fmt"
+	"math"
+	"net"
+
+	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+
+	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+// getEndpointSliceEndpoints returns the endpoints of the EndpointSlices for the port
+// of the backend with the given name and protocol. The ready endpoints are returned,
+// or the serving terminating endpoints if none is ready, so that the connections are
+// drained gracefully when all the pods of the backend are being replaced.
+func getEndpointSliceEndpoints(endpointSlices []*discoveryv1.EndpointSlice, portName string, protocol v1.Protocol) []*ir.DestinationEndpoint {
+	var (
+		readyEndpoints       []*ir.DestinationEndpoint
+		terminatingEndpoints []*ir.DestinationEndpoint
+		seen                 = map[string]struct{}{}
+	)
+	for _, endpointSlice := range endpointSlices {
+		// Only IP addresses are supported
+		if endpointSlice.AddressType == discoveryv1.AddressTypeFQDN {
+			continue
+		}
+
+		// The port of the EndpointSlice has the name of the Service port, and the
+		// number of the target port, which may be a named port of the pods.
+		for _, port := range endpointSlice.Ports {
+			if port.Port == nil || derefOr(port.Name, "") != portName || protocolDerefOrTCP(derefOr(port.Protocol, "")) != protocol {
+				continue
+			}
+
+			for _, endpoint := range endpointSlice.Endpoints {
+				// The addresses of an endpoint are fungible, only the first one is used.
+				if len(endpoint.Addresses) == 0 {
+					continue
+				}
+				// An endpoint may be listed by several EndpointSlices while it moves
+				// between them.
+				key := fmt.Sprintf("%s:%d", endpoint.Addresses[0], *port.Port)
+				if _, ok := seen[key]; ok {
+					continue
+				}
+
+				ep := ir.NewDestEndpoint(endpoint.Addresses[0], uint32(*port.Port))
+				ep.Zone = endpoint.Zone
+				if endpoint.Hints != nil {
+					for _, zone := range endpoint.Hints.ForZones {
+						ep.ZoneHints = append(ep.ZoneHints, zone.Name)
+					}
+				}
+
+				switch {
+				case endpointReady(endpoint.Conditions):
+					readyEndpoints = append(readyEndpoints, ep)
+				case endpointServingTerminating(endpoint.Conditions):
+					terminatingEndpoints = append(terminatingEndpoints, ep)
+				default:
+					continue
+				}
+				seen[key] = struct{}{}
+			}
+		}
+	}
+
+	if len(readyEndpoints) == 0 {
+		return terminatingEndpoints
+	}
+	return readyEndpoints
+}
+
+// getBackendEndpoints returns the endpoints of a Backend. The FQDN endpoints are
+// resolved by Envoy.
+func getBackendEndpoints(backend *egv1a1.Backend) []*ir.DestinationEndpoint {
+	var endpoints []*ir.DestinationEndpoint
+	for _, endpoint := range backend.Spec.Endpoints {
+		switch {
+		case endpoint.FQDN != nil:
+			endpoints = append(endpoints, ir.NewDestEndpoint(endpoint.FQDN.Hostname, uint32(endpoint.FQDN.Port)))
+		case endpoint.IP != nil:
+			endpoints = append(endpoints, ir.NewDestEndpoint(endpoint.IP.Address, uint32(endpoint.IP.Port)))
+		}
+	}
+	return endpoints
+}
+
+// endpointReady returns true if the endpoint can receive new connections.
+// A nil ready condition should be interpreted as ready.
+func endpointReady(conditions discoveryv1.EndpointConditions) bool {
+	return derefOr(conditions.Ready, true)
+}
+
+// endpointServingTerminating returns true if the endpoint is terminating, but still
+// accepts connections. A nil serving condition has the value of the ready condition.
+func endpointServingTerminating(conditions discoveryv1.EndpointConditions) bool {
+	return derefOr(conditions.Terminating, false) && derefOr(conditions.Serving, endpointReady(conditions))
+}
+
+// endpointRoutingDisabled returns true if the managed proxies route the requests to
+// the Cluster IP of the Service backends instead of their endpoints.
+func endpointRoutingDisabled(envoyProxy *egcfgv1a1.EnvoyProxy) bool {
+	return envoyProxy != nil && envoyProxy.Spec.RoutingType != nil &&
+		*envoyProxy.Spec.RoutingType == egcfgv1a1.ServiceRoutingType
+}
+
+// normalizeEndpointWeights scales the weights of the endpoints of the backends of
+// a rule, so that each backend receives a share of the requests proportional to its
+// weight whatever its number of endpoints. The endpoints of a backend share the
+// weight of the backend.
+func normalizeEndpointWeights(backends [][]*ir.DestinationEndpoint) {
+	// The least common multiple of the number of endpoints of the backends
+	// keeps the weights of the endpoints exact.
+	scale := uint64(1)
+	var totalWeight uint64
+	for _, endpoints := range backends {
+		if len(endpoints) == 0 || endpoints[0].Weight == nil {
+			continue
+		}
+		if scale < math.MaxUint32 {
+			scale = lcm(scale, uint64(len(endpoints)))
+		}
+		totalWeight += uint64(*endpoints[0].Weight)
+	}
+	if scale == 1 {
+		return
+	}
+
+	// Envoy limits the sum of the weights of the endpoints to the max uint32,
+	// the weights are approximated beyond.
+	if totalWeight > 0 && totalWeight*scale > math.MaxUint32 {
+		scale = math.MaxUint32 / totalWeight
+	}
+
+	for _, endpoints := range backends {
+		for _, ep := range endpoints {
+			if ep.Weight == nil || *ep.Weight == 0 {
+				continue
+			}
+			weight := uint64(*ep.Weight) * scale / uint64(len(endpoints))
+			if weight == 0 {
+				weight = 1
+			}
+			epWeight := uint32(weight)
+			ep.Weight = &epWeight
+		}
+	}
+}
+
+func gcd(a, b uint64) uint64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+func lcm(a, b uint64) uint64 {
+	return a / gcd(a, b) * b
+}
+
+// protocolDerefOrTCP returns the protocol of a Service port, which defaults to TCP.
+func protocolDerefOrTCP(protocol v1.Protocol) v1.Protocol {
+	if protocol == "" {
+		return v1.ProtocolTCP
+	}
+	return protocol
+}
+
+func derefOr[T any](val *T, defaultVal T) T {
+	if val == nil {
+		return defaultVal
+	}
+	return *val
+}
+
+// hasHostnameEndpoint returns true if any of the endpoints of the backends is a
+// hostname, in which case Envoy resolves the endpoints of the destination with DNS.
+func hasHostnameEndpoint(backends [][]*ir.DestinationEndpoint) bool {
+	for _, endpoints := range backends {
+		for _, ep := range endpoints {
+			if net.ParseIP(ep.Host) == nil {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -8,6 +8,7 @@ package gatewayapi
 import (
 	"fmt"
 	"net"
+	"regexp"
 	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -41,6 +42,18 @@ type HTTPFiltersContext struct {
 	ParentRef *RouteParentContext
 	Route     RouteContext
 	RuleIdx   int
+
+	// redirectExtension holds the redirect options of an Envoy Gateway HTTPRouteFilter,
+	// they are merged into the RedirectResponse once all the filters are processed.
+	redirectExtension *egv1a1.HTTPRequestRedirectFilter
+	// urlRewriteExtension holds the rewrite options of an Envoy Gateway HTTPRouteFilter,
+	// they are merged into the URLRewrite once all the filters are processed.
+	urlRewriteExtension *egv1a1.HTTPURLRewriteFilter
+	// protocolsExtension holds the protocol options of an Envoy Gateway HTTPRouteFilter.
+	protocolsExtension *egv1a1.HTTPProtocolsFilter
+	// rateLimitFilter holds the RateLimitFilter of the rule, its JWT claims are checked
+	// against the JWT providers of the rule once all the filters are processed.
+	rateLimitFilter *egv1a1.RateLimitFilter
 }
 
 // HTTPFilterIR contains the ir processing results.
@@ -56,12 +69,16 @@ type HTTPFilterIR struct {
 	AddResponseHeaders    []ir.AddHeader
 	RemoveResponseHeaders []string
 
-	Mirror *ir.RouteDestination
+	Mirrors []*ir.MirrorPolicy
 
 	RequestAuthentication *ir.RequestAuthentication
 	RateLimit             *ir.RateLimit
 
 	ExtensionRefs []*ir.UnstructuredRef
+
+	DisableWebSocket bool
+	DisableGRPCWeb   bool
+	DisableGRPCStats bool
 }
 
 // ProcessHTTPFilters translates gateway api http filters to IRs.
@@ -106,6 +123,16 @@ func (t *Translator) ProcessHTTPFilters(parentRef *RouteParentContext,
 		}
 	}
 
+	if httpFiltersContext.DirectResponse == nil && httpFiltersContext.redirectExtension != nil {
+		t.processRedirectExtension(httpFiltersContext)
+	}
+	if httpFiltersContext.DirectResponse == nil && httpFiltersContext.urlRewriteExtension != nil {
+		t.processURLRewriteExtension(httpFiltersContext)
+	}
+	if httpFiltersContext.DirectResponse == nil && httpFiltersContext.rateLimitFilter != nil {
+		t.processRateLimitJWTClaims(httpFiltersContext)
+	}
+
 	return httpFiltersContext
 }
 
@@ -146,6 +173,10 @@ func (t *Translator) ProcessGRPCFilters(parentRef *RouteParentContext,
 		}
 	}
 
+	if httpFiltersContext.DirectResponse == nil && httpFiltersContext.rateLimitFilter != nil {
+		t.processRateLimitJWTClaims(httpFiltersContext)
+	}
+
 	return httpFiltersContext
 }
 
@@ -329,11 +360,12 @@ func (t *Translator) processRedirectFilter(
 
 	if redirect.StatusCode != nil {
 		redirectCode := int32(*redirect.StatusCode)
-		// Envoy supports 302, 303, 307, and 308, but gateway API only includes 301 and 302
-		if redirectCode == 301 || redirectCode == 302 {
+		// Gateway API only includes 301 and 302, but all the redirect codes supported
+		// by Envoy are accepted. They can also be set with an Envoy Gateway HTTPRouteFilter.
+		if isSupportedRedirectCode(redirectCode) {
 			redir.StatusCode = &redirectCode
 		} else {
-			errMsg := fmt.Sprintf("Status code %d is invalid, only 302 and 301 are supported", redirectCode)
+			errMsg := fmt.Sprintf("Status code %d is invalid, only 301, 302, 303, 307 and 308 are supported", redirectCode)
 			filterContext.ParentRef.SetCondition(filterContext.Route,
 				v1beta1.RouteConditionAccepted,
 				metav1.ConditionFalse,
@@ -352,6 +384,338 @@ func (t *Translator) processRedirectFilter(
 	filterContext.RedirectResponse = redir
 }
 
+// isSupportedRedirectCode returns true if the provided status code is a
+// redirect status code supported by Envoy.
+func isSupportedRedirectCode(code int32) bool {
+	switch code {
+	case 301, 302, 303, 307, 308:
+		return true
+	default:
+		return false
+	}
+}
+
+// processHTTPRouteFilter validates the options of an Envoy Gateway HTTPRouteFilter
+// and stores them in the filter context.
+func (t *Translator) processHTTPRouteFilter(
+	routeFilter *egv1a1.HTTPRouteFilter,
+	filterContext *HTTPFiltersContext,
+	resources *Resources) {
+	// The backend options only apply to the backendRefs.
+	if routeFilter.Spec.Backend != nil {
+		filterContext.ParentRef.SetCondition(filterContext.Route,
+			v1beta1.RouteConditionAccepted,
+			metav1.ConditionFalse,
+			v1beta1.RouteReasonUnsupportedValue,
+			fmt.Sprintf("HTTPRouteFilter %s/%s with a backend can only be referenced by backendRefs",
+				routeFilter.Namespace, routeFilter.Name),
+		)
+		return
+	}
+
+	// The redirect and rewrite extensions only apply to the HTTPRoute rules.
+	if GetRouteType(filterContext.Route) != KindHTTPRoute &&
+		(routeFilter.Spec.RequestRedirect != nil || routeFilter.Spec.URLRewrite != nil) {
+		filterContext.ParentRef.SetCondition(filterContext.Route,
+			v1beta1.RouteConditionAccepted,
+			metav1.ConditionFalse,
+			v1beta1.RouteReasonUnsupportedValue,
+			fmt.Sprintf("HTTPRouteFilter %s/%s with a requestRedirect or a urlRewrite can only be referenced by %s rules",
+				routeFilter.Namespace, routeFilter.Name, KindHTTPRoute),
+		)
+		return
+	}
+
+	if redirect := routeFilter.Spec.RequestRedirect; redirect != nil {
+		// Can't have two redirect extensions for the same route
+		if filterContext.redirectExtension != nil {
+			filterContext.ParentRef.SetCondition(filterContext.Route,
+				v1beta1.RouteConditionAccepted,
+				metav1.ConditionFalse,
+				v1beta1.RouteReasonUnsupportedValue,
+				"Cannot configure multiple HTTPRouteFilters with a requestRedirect for a single HTTPRouteRule",
+			)
+			return
+		}
+
+		if redirect.StatusCode != nil && !isSupportedRedirectCode(int32(*redirect.StatusCode)) {
+			errMsg := fmt.Sprintf("Status code %d is invalid, only 301, 302, 303, 307 and 308 are supported", *redirect.StatusCode)
+			filterContext.ParentRef.SetCondition(filterContext.Route,
+				v1beta1.RouteConditionAccepted,
+				metav1.ConditionFalse,
+				v1beta1.RouteReasonUnsupportedValue,
+				errMsg,
+			)
+			return
+		}
+
+		if redirect.Path != nil {
+			if err := validateRegexPathModifier(redirect.Path); err != nil {
+				filterContext.ParentRef.SetCondition(filterContext.Route,
+					v1beta1.RouteConditionAccepted,
+					metav1.ConditionFalse,
+					v1beta1.RouteReasonUnsupportedValue,
+					fmt.Sprintf("Redirect path of HTTPRouteFilter %s/%s is invalid: %v", routeFilter.Namespace, routeFilter.Name, err),
+				)
+				return
+			}
+		}
+
+		filterContext.redirectExtension = redirect
+	}
+
+	if rewrite := routeFilter.Spec.URLRewrite; rewrite != nil {
+		// Can't have two rewrite extensions for the same route
+		if filterContext.urlRewriteExtension != nil {
+			filterContext.ParentRef.SetCondition(filterContext.Route,
+				v1beta1.RouteConditionAccepted,
+				metav1.ConditionFalse,
+				v1beta1.RouteReasonUnsupportedValue,
+				"Cannot configure multiple HTTPRouteFilters with a urlRewrite for a single HTTPRouteRule",
+			)
+			return
+		}
+
+		if rewrite.Path != nil {
+			if err := validateRegexPathModifier(rewrite.Path); err != nil {
+				filterContext.ParentRef.SetCondition(filterContext.Route,
+					v1beta1.RouteConditionAccepted,
+					metav1.ConditionFalse,
+					v1beta1.RouteReasonUnsupportedValue,
+					fmt.Sprintf("Rewrite path of HTTPRouteFilter %s/%s is invalid: %v", routeFilter.Namespace, routeFilter.Name, err),
+				)
+				return
+			}
+		}
+
+		if rewrite.Hostname != nil {
+			if err := validateHostnameModifier(rewrite.Hostname); err != nil {
+				filterContext.ParentRef.SetCondition(filterContext.Route,
+					v1beta1.RouteConditionAccepted,
+					metav1.ConditionFalse,
+					v1beta1.RouteReasonUnsupportedValue,
+					fmt.Sprintf("Rewrite hostname of HTTPRouteFilter %s/%s is invalid: %v", routeFilter.Namespace, routeFilter.Name, err),
+				)
+				return
+			}
+		}
+
+		filterContext.urlRewriteExtension = rewrite
+	}
+
+	if mirror := routeFilter.Spec.RequestMirror; mirror != nil {
+		var percentage *float32
+		if mirror.Percent != nil {
+			percent := float32(*mirror.Percent)
+			percentage = &percent
+		}
+		t.processRequestMirror(mirror.BackendRef, percentage, filterContext, resources)
+	}
+
+	if protocols := routeFilter.Spec.Protocols; protocols != nil {
+		// Can't have two protocols extensions for the same route
+		if filterContext.protocolsExtension != nil {
+			filterContext.ParentRef.SetCondition(filterContext.Route,
+				v1beta1.RouteConditionAccepted,
+				metav1.ConditionFalse,
+				v1beta1.RouteReasonUnsupportedValue,
+				"Cannot configure multiple HTTPRouteFilters with protocols for a single route rule",
+			)
+			return
+		}
+
+		if err := validateProtocolsFilter(protocols, GetRouteType(filterContext.Route)); err != nil {
+			filterContext.ParentRef.SetCondition(filterContext.Route,
+				v1beta1.RouteConditionAccepted,
+				metav1.ConditionFalse,
+				v1beta1.RouteReasonUnsupportedValue,
+				fmt.Sprintf("Protocols of HTTPRouteFilter %s/%s are invalid: %v", routeFilter.Namespace, routeFilter.Name, err),
+			)
+			return
+		}
+
+		filterContext.protocolsExtension = protocols
+		filterContext.DisableWebSocket = protocols.DisableWebSocket != nil && *protocols.DisableWebSocket
+		filterContext.DisableGRPCWeb = protocols.DisableGRPCWeb != nil && *protocols.DisableGRPCWeb
+		filterContext.DisableGRPCStats = protocols.DisableGRPCStats != nil && *protocols.DisableGRPCStats
+	}
+}
+
+// validateProtocolsFilter ensures the provided protocol options apply to the kind of the route.
+func validateProtocolsFilter(protocols *egv1a1.HTTPProtocolsFilter, routeKind v1beta1.Kind) error {
+	if routeKind != KindHTTPRoute && protocols.DisableWebSocket != nil {
+		return fmt.Errorf("disableWebSocket can only be set for %s rules", KindHTTPRoute)
+	}
+	if routeKind != KindGRPCRoute && (protocols.DisableGRPCWeb != nil || protocols.DisableGRPCStats != nil) {
+		return fmt.Errorf("disableGRPCWeb and disableGRPCStats can only be set for %s rules", KindGRPCRoute)
+	}
+	return nil
+}
+
+// validateRegexPathModifier ensures the provided path modifier holds a valid regex rewrite.
+func validateRegexPathModifier(path *egv1a1.HTTPPathModifier) error {
+	if path.Type != egv1a1.RegexHTTPPathModifier {
+		return fmt.Errorf("path type %s is unsupported, only %q is supported", path.Type, egv1a1.RegexHTTPPathModifier)
+	}
+	if path.ReplaceRegexMatch == nil {
+		return fmt.Errorf("replaceRegexMatch must be set for path type %s", path.Type)
+	}
+	if _, err := regexp.Compile(path.ReplaceRegexMatch.Pattern); err != nil {
+		return fmt.Errorf("pattern %s is not a valid regular expression: %w", path.ReplaceRegexMatch.Pattern, err)
+	}
+	return nil
+}
+
+// validateHostnameModifier ensures the provided hostname modifier is consistent with its type.
+func validateHostnameModifier(hostname *egv1a1.HTTPHostnameModifier) error {
+	switch hostname.Type {
+	case egv1a1.HeaderHTTPHostnameModifier:
+		if hostname.Header == nil || *hostname.Header == "" {
+			return fmt.Errorf("header must be set for hostname type %s", hostname.Type)
+		}
+	case egv1a1.BackendHTTPHostnameModifier:
+		if hostname.Header != nil {
+			return fmt.Errorf("header cannot be set for hostname type %s", hostname.Type)
+		}
+	default:
+		return fmt.Errorf("hostname type %s is unsupported, only %q and %q are supported",
+			hostname.Type, egv1a1.HeaderHTTPHostnameModifier, egv1a1.BackendHTTPHostnameModifier)
+	}
+	return nil
+}
+
+// processRedirectExtension merges the redirect options of an Envoy Gateway HTTPRouteFilter
+// into the redirect of the route rule, creating the redirect if the rule has none.
+func (t *Translator) processRedirectExtension(filterContext *HTTPFiltersContext) {
+	redirect := filterContext.redirectExtension
+
+	redir := filterContext.RedirectResponse
+	if redir == nil {
+		redir = &ir.Redirect{}
+	}
+
+	if redirect.StatusCode != nil {
+		redirectCode := int32(*redirect.StatusCode)
+		redir.StatusCode = &redirectCode
+	}
+
+	if redirect.StripQuery != nil {
+		redir.StripQuery = *redirect.StripQuery
+	}
+
+	if redirect.Path != nil {
+		redir.Path = &ir.HTTPPathModifier{
+			RegexMatchReplace: &ir.RegexMatchReplace{
+				Pattern:      redirect.Path.ReplaceRegexMatch.Pattern,
+				Substitution: redirect.Path.ReplaceRegexMatch.Substitution,
+			},
+		}
+	}
+
+	filterContext.RedirectResponse = redir
+}
+
+// processURLRewriteExtension merges the rewrite options of an Envoy Gateway HTTPRouteFilter
+// into the URL rewrite of the route rule, creating the URL rewrite if the rule has none.
+func (t *Translator) processURLRewriteExtension(filterContext *HTTPFiltersContext) {
+	rewrite := filterContext.urlRewriteExtension
+
+	urlRewrite := filterContext.URLRewrite
+	if urlRewrite == nil {
+		urlRewrite = &ir.URLRewrite{}
+	}
+
+	if rewrite.Path != nil {
+		if urlRewrite.Path != nil {
+			filterContext.ParentRef.SetCondition(filterContext.Route,
+				v1beta1.RouteConditionAccepted,
+				metav1.ConditionFalse,
+				v1beta1.RouteReasonUnsupportedValue,
+				"Cannot configure both a urlRewrite filter path and an HTTPRouteFilter urlRewrite path for a single HTTPRouteRule",
+			)
+			return
+		}
+		urlRewrite.Path = &ir.HTTPPathModifier{
+			RegexMatchReplace: &ir.RegexMatchReplace{
+				Pattern:      rewrite.Path.ReplaceRegexMatch.Pattern,
+				Substitution: rewrite.Path.ReplaceRegexMatch.Substitution,
+			},
+		}
+	}
+
+	if rewrite.Hostname != nil {
+		if urlRewrite.Hostname != nil {
+			filterContext.ParentRef.SetCondition(filterContext.Route,
+				v1beta1.RouteConditionAccepted,
+				metav1.ConditionFalse,
+				v1beta1.RouteReasonUnsupportedValue,
+				"Cannot configure both a urlRewrite filter hostname and an HTTPRouteFilter urlRewrite hostname for a single HTTPRouteRule",
+			)
+			return
+		}
+		switch rewrite.Hostname.Type {
+		case egv1a1.HeaderHTTPHostnameModifier:
+			urlRewrite.HostnameFromHeader = rewrite.Hostname.Header
+		case egv1a1.BackendHTTPHostnameModifier:
+			urlRewrite.AutoHostname = true
+		}
+	}
+
+	filterContext.URLRewrite = urlRewrite
+}
+
+// validateRegexRewriteForPathMatch ensures the regex path rewrite can be applied to the
+// requests selected by the provided path match, i.e. that the rewrite is not a no-op.
+func validateRegexRewriteForPathMatch(pathMatch *ir.StringMatch, rewrite *ir.RegexMatchReplace) error {
+	if pathMatch == nil {
+		return nil
+	}
+
+	re, err := regexp.Compile(rewrite.Pattern)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case pathMatch.Exact != nil:
+		if !re.MatchString(*pathMatch.Exact) {
+			return fmt.Errorf("rewrite pattern %s does not match the exact path %s", rewrite.Pattern, *pathMatch.Exact)
+		}
+	case pathMatch.Prefix != nil:
+		if literalPrefixesDiverge(rewrite.Pattern, *pathMatch.Prefix) {
+			return fmt.Errorf("rewrite pattern %s does not match paths with the prefix %s", rewrite.Pattern, *pathMatch.Prefix)
+		}
+	case pathMatch.SafeRegex != nil:
+		// The path regex must match the whole path, so its literal prefix is
+		// the beginning of all the matched paths.
+		pathRe, err := regexp.Compile(*pathMatch.SafeRegex)
+		if err != nil {
+			return err
+		}
+		prefix, _ := pathRe.LiteralPrefix()
+		if literalPrefixesDiverge(rewrite.Pattern, prefix) {
+			return fmt.Errorf("rewrite pattern %s does not match paths matching %s", rewrite.Pattern, *pathMatch.SafeRegex)
+		}
+	}
+
+	return nil
+}
+
+// literalPrefixesDiverge returns true if the pattern is anchored to the beginning
+// of the path, and its literal prefix and the path prefix diverge. Patterns that
+// aren't anchored don't constrain the beginning of the path.
+func literalPrefixesDiverge(pattern, prefix string) bool {
+	if !strings.HasPrefix(pattern, "^") {
+		return false
+	}
+	anchored, err := regexp.Compile(strings.TrimPrefix(pattern, "^"))
+	if err != nil {
+		return false
+	}
+	literal, _ := anchored.LiteralPrefix()
+	return !strings.HasPrefix(literal, prefix) && !strings.HasPrefix(prefix, literal)
+}
+
 func (t *Translator) processRequestHeaderModifierFilter(
 	headerModifier *v1beta1.HTTPHeaderFilter,
 	filterContext *HTTPFiltersContext) {
@@ -681,97 +1045,64 @@ func (t *Translator) processExtensionRefHTTPFilter(extFilter *v1beta1.LocalObjec
 		}
 	}
 
+	// Set the filter context and return early if a matching HTTPRouteFilter is found.
+	if string(extFilter.Kind) == egv1a1.KindHTTPRouteFilter {
+		for _, routeFilter := range resources.HTTPRouteFilters {
+			if routeFilter.Namespace == filterNs &&
+				routeFilter.Name == string(extFilter.Name) {
+				t.processHTTPRouteFilter(routeFilter, filterContext, resources)
+				return
+			}
+		}
+	}
+
 	// Set the filter context and return early if a matching RateLimitFilter is found.
 	if string(extFilter.Kind) == egv1a1.KindRateLimitFilter {
 		for _, rateLimitFilter := range resources.RateLimitFilters {
 			if rateLimitFilter.Namespace == filterNs &&
 				rateLimitFilter.Name == string(extFilter.Name) {
-				if rateLimitFilter.Spec.Global == nil {
-					errMsg := fmt.Sprintf("Global configuration empty for RateLimitFilter: %s/%s", filterNs,
-						extFilter.Name)
-					t.processUnresolvedHTTPFilter(errMsg, filterContext)
-					return
-				}
-				if !t.GlobalRateLimitEnabled {
-					errMsg := fmt.Sprintf("Enable Ratelimit in the EnvoyGateway config to configure RateLimitFilter: %s/%s",
-						filterNs, extFilter.Name)
-					t.processUnresolvedHTTPFilter(errMsg, filterContext)
-					return
-				}
-				rateLimit := &ir.RateLimit{
-					Global: &ir.GlobalRateLimit{
-						Rules: make([]*ir.RateLimitRule, len(rateLimitFilter.Spec.Global.Rules)),
-					},
-				}
-				rules := rateLimit.Global.Rules
-				for i, rule := range rateLimitFilter.Spec.Global.Rules {
-					rules[i] = &ir.RateLimitRule{
-						Limit: &ir.RateLimitValue{
-							Requests: rule.Limit.Requests,
-							Unit:     ir.RateLimitUnit(rule.Limit.Unit),
+				var rateLimit *ir.RateLimit
+				if rateLimitFilter.Spec.Type == egv1a1.LocalRateLimitType {
+					if rateLimitFilter.Spec.Local == nil {
+						errMsg := fmt.Sprintf("Local configuration empty for RateLimitFilter: %s/%s", filterNs,
+							extFilter.Name)
+						t.processUnresolvedHTTPFilter(errMsg, filterContext)
+						return
+					}
+					rules, ok := t.buildRateLimitRules(rateLimitFilter, rateLimitFilter.Spec.Local.Rules, filterContext)
+					if !ok {
+						return
+					}
+					rateLimit = &ir.RateLimit{
+						Local: &ir.LocalRateLimit{
+							Rules: rules,
 						},
-						HeaderMatches: make([]*ir.StringMatch, 0),
 					}
-					for _, match := range rule.ClientSelectors {
-						for _, header := range match.Headers {
-							switch {
-							case header.Type == nil && header.Value != nil:
-								fallthrough
-							case *header.Type == egv1a1.HeaderMatchExact && header.Value != nil:
-								m := &ir.StringMatch{
-									Name:  header.Name,
-									Exact: header.Value,
-								}
-								rules[i].HeaderMatches = append(rules[i].HeaderMatches, m)
-							case *header.Type == egv1a1.HeaderMatchRegularExpression && header.Value != nil:
-								m := &ir.StringMatch{
-									Name:      header.Name,
-									SafeRegex: header.Value,
-								}
-								rules[i].HeaderMatches = append(rules[i].HeaderMatches, m)
-							case *header.Type == egv1a1.HeaderMatchDistinct && header.Value == nil:
-								m := &ir.StringMatch{
-									Name:     header.Name,
-									Distinct: true,
-								}
-								rules[i].HeaderMatches = append(rules[i].HeaderMatches, m)
-							default:
-								// set negative status condition.
-								errMsg := fmt.Sprintf("Unable to translate RateLimitFilter. Either the header.Type is not valid or the header is missing a value: %s/%s", filterNs,
-									extFilter.Name)
-								t.processUnresolvedHTTPFilter(errMsg, filterContext)
-								return
-							}
-						}
-
-						if match.SourceCIDR != nil {
-							// distinct means that each IP Address within the specified Source IP CIDR is treated as a
-							// distinct client selector and uses a separate rate limit bucket/counter.
-							distinct := false
-							sourceCIDR := match.SourceCIDR.Value
-							if match.SourceCIDR.Type != nil && *match.SourceCIDR.Type == egv1a1.SourceMatchDistinct {
-								distinct = true
-							}
-
-							ip, ipn, err := net.ParseCIDR(sourceCIDR)
-							if err != nil {
-								errMsg := fmt.Sprintf("Unable to translate RateLimitFilter: %s/%s", filterNs,
-									extFilter.Name)
-								t.processUnresolvedHTTPFilter(errMsg, filterContext)
-								return
-							}
-
-							mask, _ := ipn.Mask.Size()
-							rules[i].CIDRMatch = &ir.CIDRMatch{
-								CIDR:     ipn.String(),
-								IPv6:     ip.To4() == nil,
-								MaskLen:  mask,
-								Distinct: distinct,
-							}
-						}
+				} else {
+					if rateLimitFilter.Spec.Global == nil {
+						errMsg := fmt.Sprintf("Global configuration empty for RateLimitFilter: %s/%s", filterNs,
+							extFilter.Name)
+						t.processUnresolvedHTTPFilter(errMsg, filterContext)
+						return
+					}
+					if !t.GlobalRateLimitEnabled {
+						errMsg := fmt.Sprintf("Enable Ratelimit in the EnvoyGateway config to configure RateLimitFilter: %s/%s",
+							filterNs, extFilter.Name)
+						t.processUnresolvedHTTPFilter(errMsg, filterContext)
+						return
+					}
+					rules, ok := t.buildRateLimitRules(rateLimitFilter, rateLimitFilter.Spec.Global.Rules, filterContext)
+					if !ok {
+						return
+					}
+					rateLimit = &ir.RateLimit{
+						Global: &ir.GlobalRateLimit{
+							Rules: rules,
+						},
 					}
 				}
 				filterContext.HTTPFilterIR.RateLimit = rateLimit
+				filterContext.rateLimitFilter = rateLimitFilter
 				return
 			}
 		}
@@ -807,6 +1138,278 @@ func (t *Translator) processExtensionRefHTTPFilter(extFilter *v1beta1.LocalObjec
 	t.processUnresolvedHTTPFilter(errMsg, filterContext)
 }
 
+// buildRateLimitRules translates the rules of the RateLimitFilter to the IR rate limit rules,
+// and sets a negative status condition if they can't be translated. The Distinct selectors
+// are rejected for local rate limits, since their limits are enforced with the token buckets
+// of a static set of descriptors.
+func (t *Translator) buildRateLimitRules(rateLimitFilter *egv1a1.RateLimitFilter, rateLimitRules []egv1a1.RateLimitRule,
+	filterContext *HTTPFiltersContext) ([]*ir.RateLimitRule, bool) {
+	local := rateLimitFilter.Spec.Type == egv1a1.LocalRateLimitType
+	rules := make([]*ir.RateLimitRule, len(rateLimitRules))
+	for i, rule := range rateLimitRules {
+		shadowMode := rule.ShadowMode != nil && *rule.ShadowMode
+		if local && shadowMode {
+			errMsg := fmt.Sprintf("Unable to translate RateLimitFilter. Local rate limits don't support shadow mode: %s/%s",
+				rateLimitFilter.Namespace, rateLimitFilter.Name)
+			t.processUnresolvedHTTPFilter(errMsg, filterContext)
+			return nil, false
+		}
+		var unitMultiplier uint32
+		if rule.Limit.UnitMultiplier != nil {
+			unitMultiplier = *rule.Limit.UnitMultiplier
+		}
+		if !local && unitMultiplier > 1 {
+			errMsg := fmt.Sprintf("Unable to translate RateLimitFilter. Global rate limits don't support unit multipliers: %s/%s",
+				rateLimitFilter.Namespace, rateLimitFilter.Name)
+			t.processUnresolvedHTTPFilter(errMsg, filterContext)
+			return nil, false
+		}
+		var cost uint32
+		if rule.Cost != nil {
+			cost = *rule.Cost
+		}
+		// The cost is applied by dividing the limit, which must be exact for the
+		// limit to allow the configured number of hits.
+		if cost > 1 && rule.Limit.Requests%uint(cost) != 0 {
+			errMsg := fmt.Sprintf("Unable to translate RateLimitFilter. The limit of %d requests is not a multiple of the cost %d: %s/%s",
+				rule.Limit.Requests, cost, rateLimitFilter.Namespace, rateLimitFilter.Name)
+			t.processUnresolvedHTTPFilter(errMsg, filterContext)
+			return nil, false
+		}
+		rules[i] = &ir.RateLimitRule{
+			Limit: &ir.RateLimitValue{
+				Requests:       rule.Limit.Requests,
+				Unit:           ir.RateLimitUnit(rule.Limit.Unit),
+				UnitMultiplier: unitMultiplier,
+			},
+			HeaderMatches: make([]*ir.StringMatch, 0),
+			Cost:          cost,
+			ShadowMode:    shadowMode,
+		}
+		for _, match := range rule.ClientSelectors {
+			for _, header := range match.Headers {
+				switch {
+				case header.Type == nil && header.Value != nil:
+					fallthrough
+				case *header.Type == egv1a1.HeaderMatchExact && header.Value != nil:
+					m := &ir.StringMatch{
+						Name:  header.Name,
+						Exact: header.Value,
+					}
+					rules[i].HeaderMatches = append(rules[i].HeaderMatches, m)
+				case *header.Type == egv1a1.HeaderMatchRegularExpression && header.Value != nil:
+					m := &ir.StringMatch{
+						Name:      header.Name,
+						SafeRegex: header.Value,
+					}
+					rules[i].HeaderMatches = append(rules[i].HeaderMatches, m)
+				case *header.Type == egv1a1.HeaderMatchDistinct && header.Value == nil:
+					if local {
+						errMsg := fmt.Sprintf("Unable to translate RateLimitFilter. Local rate limits don't support Distinct headers: %s/%s",
+							rateLimitFilter.Namespace, rateLimitFilter.Name)
+						t.processUnresolvedHTTPFilter(errMsg, filterContext)
+						return nil, false
+					}
+					m := &ir.StringMatch{
+						Name:     header.Name,
+						Distinct: true,
+					}
+					rules[i].HeaderMatches = append(rules[i].HeaderMatches, m)
+				default:
+					// set negative status condition.
+					errMsg := fmt.Sprintf("Unable to translate RateLimitFilter. Either the header.Type is not valid or the header is missing a value: %s/%s",
+						rateLimitFilter.Namespace, rateLimitFilter.Name)
+					t.processUnresolvedHTTPFilter(errMsg, filterContext)
+					return nil, false
+				}
+			}
+
+			if match.SourceCIDR != nil {
+				// distinct means that each IP Address within the specified Source IP CIDR is treated as a
+				// distinct client selector and uses a separate rate limit bucket/counter.
+				distinct := false
+				sourceCIDR := match.SourceCIDR.Value
+				if match.SourceCIDR.Type != nil && *match.SourceCIDR.Type == egv1a1.SourceMatchDistinct {
+					if local {
+						errMsg := fmt.Sprintf("Unable to translate RateLimitFilter. Local rate limits don't support Distinct source CIDRs: %s/%s",
+							rateLimitFilter.Namespace, rateLimitFilter.Name)
+						t.processUnresolvedHTTPFilter(errMsg, filterContext)
+						return nil, false
+					}
+					distinct = true
+				}
+
+				ip, ipn, err := net.ParseCIDR(sourceCIDR)
+				if err != nil {
+					errMsg := fmt.Sprintf("Unable to translate RateLimitFilter: %s/%s",
+						rateLimitFilter.Namespace, rateLimitFilter.Name)
+					t.processUnresolvedHTTPFilter(errMsg, filterContext)
+					return nil, false
+				}
+
+				mask, _ := ipn.Mask.Size()
+				rules[i].CIDRMatch = &ir.CIDRMatch{
+					CIDR:     ipn.String(),
+					IPv6:     ip.To4() == nil,
+					MaskLen:  mask,
+					Distinct: distinct,
+				}
+			}
+
+			if match.Path != nil {
+				rules[i].HeaderMatches = append(rules[i].HeaderMatches, &ir.StringMatch{
+					Name:      ":path",
+					SafeRegex: StringPtr(rateLimitPathRegex(match.Path)),
+				})
+			}
+
+			if len(match.Methods) > 0 {
+				methods := make([]string, len(match.Methods))
+				for j, method := range match.Methods {
+					methods[j] = string(method)
+				}
+				rules[i].HeaderMatches = append(rules[i].HeaderMatches, &ir.StringMatch{
+					Name:      ":method",
+					SafeRegex: StringPtr(strings.Join(methods, "|")),
+				})
+			}
+
+			for _, queryParam := range match.QueryParams {
+				switch {
+				case queryParam.Type == nil && queryParam.Value != nil:
+					fallthrough
+				case *queryParam.Type == egv1a1.HeaderMatchExact && queryParam.Value != nil:
+					m := &ir.StringMatch{
+						Name:  queryParam.Name,
+						Exact: queryParam.Value,
+					}
+					rules[i].QueryParamMatches = append(rules[i].QueryParamMatches, m)
+				case *queryParam.Type == egv1a1.HeaderMatchRegularExpression && queryParam.Value != nil:
+					m := &ir.StringMatch{
+						Name:      queryParam.Name,
+						SafeRegex: queryParam.Value,
+					}
+					rules[i].QueryParamMatches = append(rules[i].QueryParamMatches, m)
+				case *queryParam.Type == egv1a1.HeaderMatchDistinct && queryParam.Value == nil:
+					if local {
+						errMsg := fmt.Sprintf("Unable to translate RateLimitFilter. Local rate limits don't support Distinct query parameters: %s/%s",
+							rateLimitFilter.Namespace, rateLimitFilter.Name)
+						t.processUnresolvedHTTPFilter(errMsg, filterContext)
+						return nil, false
+					}
+					m := &ir.StringMatch{
+						Name:     queryParam.Name,
+						Distinct: true,
+					}
+					rules[i].QueryParamMatches = append(rules[i].QueryParamMatches, m)
+				default:
+					errMsg := fmt.Sprintf("Unable to translate RateLimitFilter. Either the queryParam.Type is not valid or the query parameter is missing a value: %s/%s",
+						rateLimitFilter.Namespace, rateLimitFilter.Name)
+					t.processUnresolvedHTTPFilter(errMsg, filterContext)
+					return nil, false
+				}
+			}
+
+			for _, claim := range match.JWTClaims {
+				switch {
+				case claim.Type == nil && claim.Value != nil:
+					fallthrough
+				case *claim.Type == egv1a1.JWTClaimMatchExact && claim.Value != nil:
+					m := &ir.StringMatch{
+						Name:  claim.Name,
+						Exact: claim.Value,
+					}
+					rules[i].JWTClaimMatches = append(rules[i].JWTClaimMatches, m)
+				case *claim.Type == egv1a1.JWTClaimMatchDistinct && claim.Value == nil:
+					if local {
+						errMsg := fmt.Sprintf("Unable to translate RateLimitFilter. Local rate limits don't support Distinct JWT claims: %s/%s",
+							rateLimitFilter.Namespace, rateLimitFilter.Name)
+						t.processUnresolvedHTTPFilter(errMsg, filterContext)
+						return nil, false
+					}
+					m := &ir.StringMatch{
+						Name:     claim.Name,
+						Distinct: true,
+					}
+					rules[i].JWTClaimMatches = append(rules[i].JWTClaimMatches, m)
+				default:
+					errMsg := fmt.Sprintf("Unable to translate RateLimitFilter. Either the jwtClaim.Type is not valid or the claim is missing a value: %s/%s",
+						rateLimitFilter.Namespace, rateLimitFilter.Name)
+					t.processUnresolvedHTTPFilter(errMsg, filterContext)
+					return nil, false
+				}
+			}
+		}
+	}
+
+	return rules, true
+}
+
+// processRateLimitJWTClaims sets a negative status condition if the rate limit rules
+// select JWT claims, but the rule has no JWT provider with an issuer. The claims are
+// read from the verified payloads stored per issuer, so they can't be selected otherwise.
+func (t *Translator) processRateLimitJWTClaims(filterContext *HTTPFiltersContext) {
+	if !rateLimitSelectsJWTClaims(filterContext.RateLimit) {
+		return
+	}
+
+	if filterContext.RequestAuthentication != nil && filterContext.RequestAuthentication.JWT != nil {
+		for _, provider := range filterContext.RequestAuthentication.JWT.Providers {
+			if provider.Issuer != "" {
+				return
+			}
+		}
+	}
+
+	errMsg := fmt.Sprintf("Unable to translate RateLimitFilter. The JWT claims require an AuthenticationFilter with a JWT provider that has an issuer: %s/%s",
+		filterContext.rateLimitFilter.Namespace, filterContext.rateLimitFilter.Name)
+	t.processUnresolvedHTTPFilter(errMsg, filterContext)
+}
+
+// rateLimitSelectsJWTClaims returns true if a rule of the rate limit selects JWT claims.
+func rateLimitSelectsJWTClaims(rateLimit *ir.RateLimit) bool {
+	var rules []*ir.RateLimitRule
+	switch {
+	case rateLimit == nil:
+		return false
+	case rateLimit.Local != nil:
+		rules = rateLimit.Local.Rules
+	case rateLimit.Global != nil:
+		rules = rateLimit.Global.Rules
+	}
+
+	for _, rule := range rules {
+		if len(rule.JWTClaimMatches) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// rateLimitPathRegex returns the regex matching the :path header of the requests
+// whose path matches the path match, with or without a query string.
+func rateLimitPathRegex(path *v1beta1.HTTPPathMatch) string {
+	value := "/"
+	if path.Value != nil {
+		value = *path.Value
+	}
+
+	switch PathMatchTypeDerefOr(path.Type, v1beta1.PathMatchPathPrefix) {
+	case v1beta1.PathMatchExact:
+		return regexp.QuoteMeta(value) + `(\?.*)?`
+	case v1beta1.PathMatchRegularExpression:
+		return "(" + value + `)(\?.*)?`
+	default:
+		// The path prefix matches the complete path elements, e.g. "/foo"
+		// matches "/foo" and "/foo/bar", but not "/foobar".
+		prefix := strings.TrimSuffix(value, "/")
+		if prefix == "" {
+			return "/.*"
+		}
+		return regexp.QuoteMeta(prefix) + `([/?].*)?`
+	}
+}
+
 func (t *Translator) processRequestMirrorFilter(
 	mirrorFilter *v1beta1.HTTPRequestMirrorFilter,
 	filterContext *HTTPFiltersContext,
@@ -817,8 +1420,16 @@ func (t *Translator) processRequestMirrorFilter(
 		return
 	}
 
-	mirrorBackend := mirrorFilter.BackendRef
+	t.processRequestMirror(mirrorFilter.BackendRef, nil, filterContext, resources)
+}
 
+// processRequestMirror adds a mirror policy for the provided backend to the filter context,
+// every mirror filter of a rule gets its own mirror destination.
+func (t *Translator) processRequestMirror(
+	mirrorBackend v1beta1.BackendObjectReference,
+	percentage *float32,
+	filterContext *HTTPFiltersContext,
+	resources *Resources) {
 	// Wrap the filter's BackendObjectReference into a BackendRef so we can use existing tooling to check it
 	weight := int32(1)
 	mirrorBackendRef := v1beta1.BackendRef{
@@ -835,28 +1446,52 @@ func (t *Translator) processRequestMirrorFilter(
 	}
 
 	mirrorEndpoints, _ := t.processDestEndpoints(mirrorBackendRef, filterContext.ParentRef, filterContext.Route, resources)
+	if len(mirrorEndpoints) == 0 {
+		return
+	}
 
-	// Only add missing mirror destinations
-	for _, mirrorEp := range mirrorEndpoints {
-		var found bool
-		if filterContext.Mirror != nil {
-			for _, mirror := range filterContext.Mirror.Endpoints {
-				if mirror != nil {
-					if mirror.Host == mirrorEp.Host && mirror.Port == mirrorEp.Port {
-						found = true
-					}
-				}
-			}
+	// The mirrors of a rule to the same backend are merged, mirroring the
+	// highest percentage of the requests.
+	for _, mirror := range filterContext.Mirrors {
+		if sameDestinationEndpoints(mirror.Destination.Endpoints, mirrorEndpoints) {
+			mirror.Percentage = maxMirrorPercentage(mirror.Percentage, percentage)
+			return
 		}
-		if !found {
-			if filterContext.Mirror == nil {
-				filterContext.Mirror = &ir.RouteDestination{
-					Name: fmt.Sprintf("%s-mirror", irRouteDestinationName(filterContext.Route, filterContext.RuleIdx)),
-				}
-			}
-			filterContext.Mirror.Endpoints = append(filterContext.Mirror.Endpoints, mirrorEp)
+	}
+
+	filterContext.Mirrors = append(filterContext.Mirrors, &ir.MirrorPolicy{
+		Destination: &ir.RouteDestination{
+			Name: fmt.Sprintf("%s-mirror-%d", irRouteDestinationName(filterContext.Route, filterContext.RuleIdx),
+				len(filterContext.Mirrors)),
+			Endpoints: mirrorEndpoints,
+		},
+		Percentage: percentage,
+	})
+}
+
+// maxMirrorPercentage returns the highest of the mirror percentages, a nil
+// percentage mirrors all the requests.
+func maxMirrorPercentage(a, b *float32) *float32 {
+	if a == nil || b == nil {
+		return nil
+	}
+	if *a > *b {
+		return a
+	}
+	return b
+}
+
+// sameDestinationEndpoints returns true if both lists hold the same hosts and ports.
+func sameDestinationEndpoints(a, b []*ir.DestinationEndpoint) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Host != b[i].Host || a[i].Port != b[i].Port {
+			return false
 		}
 	}
+	return true
 }
 
 func (t *Translator) processUnresolvedHTTPFilter(errMsg string, filterContext *HTTPFiltersContext) {
@@ -0,0 +1,252 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package gatewayapi
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	gwv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/status"
+)
+
+// ProcessGRPCJSONTranscoderPolicies applies the GRPCJSONTranscoderPolicies to the
+// routes of the GRPCRoutes they target.
+func (t *Translator) ProcessGRPCJSONTranscoderPolicies(grpcJSONTranscoderPolicies []*egv1a1.GRPCJSONTranscoderPolicy,
+	grpcRoutes []*GRPCRouteContext,
+	resources *Resources,
+	xdsIR XdsIRMap) []*egv1a1.GRPCJSONTranscoderPolicy {
+	var res []*egv1a1.GRPCJSONTranscoderPolicy
+
+	// Sort based on creation timestamp, the oldest policy wins when several
+	// policies target the same GRPCRoute.
+	sort.Slice(grpcJSONTranscoderPolicies, func(i, j int) bool {
+		if grpcJSONTranscoderPolicies[i].CreationTimestamp.Equal(&(grpcJSONTranscoderPolicies[j].CreationTimestamp)) {
+			return grpcJSONTranscoderPolicies[i].Name < grpcJSONTranscoderPolicies[j].Name
+		}
+		return grpcJSONTranscoderPolicies[i].CreationTimestamp.Before(&(grpcJSONTranscoderPolicies[j].CreationTimestamp))
+	})
+
+	grpcRouteMap := make(map[types.NamespacedName]*GRPCRouteContext, len(grpcRoutes))
+	for _, grpcRoute := range grpcRoutes {
+		grpcRouteMap[types.NamespacedName{Namespace: grpcRoute.Namespace, Name: grpcRoute.Name}] = grpcRoute
+	}
+
+	handledRoutes := make(map[types.NamespacedName]*egv1a1.GRPCJSONTranscoderPolicy)
+	for _, policy := range grpcJSONTranscoderPolicies {
+		policy := policy.DeepCopy()
+		targetRef := policy.Spec.TargetRef
+		targetNs := NamespaceDerefOr(targetRef.Namespace, policy.Namespace)
+		key := types.NamespacedName{Namespace: targetNs, Name: string(targetRef.Name)}
+		res = append(res, policy)
+
+		// Ensure policy can only target a GRPCRoute
+		if targetRef.Group != gwv1b1.GroupName || targetRef.Kind != KindGRPCRoute {
+			message := fmt.Sprintf("TargetRef.Group:%s TargetRef.Kind:%s, only TargetRef.Group:%s and TargetRef.Kind:%s is supported.",
+				targetRef.Group, targetRef.Kind, gwv1b1.GroupName, KindGRPCRoute)
+
+			status.SetGRPCJSONTranscoderPolicyCondition(policy,
+				gwv1a2.PolicyConditionAccepted,
+				metav1.ConditionFalse,
+				gwv1a2.PolicyReasonInvalid,
+				message,
+			)
+			continue
+		}
+
+		// Ensure Policy and target GRPCRoute are in the same namespace
+		if policy.Namespace != targetNs {
+			message := fmt.Sprintf("Namespace:%s TargetRef.Namespace:%s, GRPCJSONTranscoderPolicy can only target a GRPCRoute in the same namespace.",
+				policy.Namespace, targetNs)
+
+			status.SetGRPCJSONTranscoderPolicyCondition(policy,
+				gwv1a2.PolicyConditionAccepted,
+				metav1.ConditionFalse,
+				gwv1a2.PolicyReasonInvalid,
+				message,
+			)
+			continue
+		}
+
+		grpcRoute, ok := grpcRouteMap[key]
+		if !ok {
+			message := fmt.Sprintf("GRPCRoute:%s not found.", targetRef.Name)
+
+			status.SetGRPCJSONTranscoderPolicyCondition(policy,
+				gwv1a2.PolicyConditionAccepted,
+				metav1.ConditionFalse,
+				gwv1a2.PolicyReasonTargetNotFound,
+				message,
+			)
+			continue
+		}
+
+		// Ensure the GRPCRoute is not already targeted by another policy
+		if winner, ok := handledRoutes[key]; ok {
+			message := fmt.Sprintf("GRPCRoute:%s is already targeted by GRPCJSONTranscoderPolicy:%s.", grpcRoute.Name, winner.Name)
+
+			status.SetGRPCJSONTranscoderPolicyCondition(policy,
+				gwv1a2.PolicyConditionAccepted,
+				metav1.ConditionFalse,
+				gwv1a2.PolicyReasonConflicted,
+				message,
+			)
+			continue
+		}
+
+		transcoder, err := buildGRPCJSONTranscoder(policy, resources)
+		if err != nil {
+			status.SetGRPCJSONTranscoderPolicyCondition(policy,
+				gwv1a2.PolicyConditionAccepted,
+				metav1.ConditionFalse,
+				gwv1a2.PolicyReasonInvalid,
+				err.Error(),
+			)
+			continue
+		}
+		handledRoutes[key] = policy
+
+		// Apply the transcoder to all the IR routes of the GRPCRoute.
+		for _, route := range getIRRoutes(grpcRoute, grpcRoute.ParentRefs, xdsIR) {
+			grpc := ir.GRPCFeatures{}
+			if route.GRPC != nil {
+				grpc = *route.GRPC
+			}
+			grpc.JSONTranscoder = transcoder
+			route.GRPC = &grpc
+		}
+
+		// Set Accepted=True
+		status.SetGRPCJSONTranscoderPolicyCondition(policy,
+			gwv1a2.PolicyConditionAccepted,
+			metav1.ConditionTrue,
+			gwv1a2.PolicyReasonAccepted,
+			"GRPCJSONTranscoderPolicy has been accepted.",
+		)
+	}
+
+	return res
+}
+
+// getIRRoutes returns the IR routes created for an HTTPRoute or a GRPCRoute.
+func getIRRoutes(route RouteContext, parentRefs map[gwv1b1.ParentReference]*RouteParentContext, xdsIR XdsIRMap) []*ir.HTTPRoute {
+	var routes []*ir.HTTPRoute
+	prefix := irRoutePrefix(route)
+	for _, parentRef := range parentRefs {
+		for _, listener := range parentRef.listeners {
+			gwXdsIR, ok := xdsIR[irStringKey(listener.gateway.Namespace, listener.gateway.Name)]
+			if !ok {
+				continue
+			}
+			irListener := gwXdsIR.GetHTTPListener(irHTTPListenerName(listener))
+			if irListener == nil {
+				continue
+			}
+			for _, irRoute := range irListener.Routes {
+				if strings.HasPrefix(irRoute.Name, prefix) {
+					routes = append(routes, irRoute)
+				}
+			}
+		}
+	}
+	return routes
+}
+
+func buildGRPCJSONTranscoder(policy *egv1a1.GRPCJSONTranscoderPolicy, resources *Resources) (*ir.GRPCJSONTranscoder, error) {
+	descriptorSet, err := getDescriptorSet(policy.Spec.DescriptorSetRef, policy.Namespace, resources)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateDescriptorSet(descriptorSet, policy.Spec.Services); err != nil {
+		return nil, err
+	}
+
+	transcoder := &ir.GRPCJSONTranscoder{
+		DescriptorSet: descriptorSet,
+		Services:      policy.Spec.Services,
+	}
+	if options := policy.Spec.PrintOptions; options != nil {
+		transcoder.PrintOptions = &ir.GRPCJSONPrintOptions{
+			AddWhitespace:              options.AddWhitespace,
+			AlwaysPrintPrimitiveFields: options.AlwaysPrintPrimitiveFields,
+			AlwaysPrintEnumsAsInts:     options.AlwaysPrintEnumsAsInts,
+			PreserveProtoFieldNames:    options.PreserveProtoFieldNames,
+		}
+	}
+	return transcoder, nil
+}
+
+// getDescriptorSet returns the descriptor set held by the referenced ConfigMap or Secret.
+func getDescriptorSet(ref gwv1b1.LocalObjectReference, namespace string, resources *Resources) ([]byte, error) {
+	key := egv1a1.GRPCJSONTranscoderDescriptorSetKey
+	if ref.Group != "" || (ref.Kind != KindConfigMap && ref.Kind != KindSecret) {
+		return nil, fmt.Errorf("descriptorSetRef.group:%s descriptorSetRef.kind:%s, only the core group and kinds %s and %s are supported",
+			ref.Group, ref.Kind, KindConfigMap, KindSecret)
+	}
+
+	if ref.Kind == KindSecret {
+		secret := resources.GetSecret(namespace, string(ref.Name))
+		if secret == nil {
+			return nil, fmt.Errorf("Secret %s/%s not found", namespace, ref.Name)
+		}
+		data, ok := secret.Data[key]
+		if !ok {
+			return nil, fmt.Errorf("Secret %s/%s does not contain the %s key", namespace, ref.Name, key)
+		}
+		return data, nil
+	}
+
+	configMap := resources.GetConfigMap(namespace, string(ref.Name))
+	if configMap == nil {
+		return nil, fmt.Errorf("ConfigMap %s/%s not found", namespace, ref.Name)
+	}
+	if data, ok := configMap.BinaryData[key]; ok {
+		return data, nil
+	}
+	if data, ok := configMap.Data[key]; ok {
+		return []byte(data), nil
+	}
+	return nil, fmt.Errorf("ConfigMap %s/%s does not contain the %s key", namespace, ref.Name, key)
+}
+
+// validateDescriptorSet ensures the descriptor set is complete and defines the services.
+func validateDescriptorSet(descriptorSet []byte, services []string) error {
+	fds := &descriptorpb.FileDescriptorSet{}
+	// The messages of the protobuf errors are unstable by design, so they are
+	// not surfaced in the status of the policy.
+	if err := proto.Unmarshal(descriptorSet, fds); err != nil {
+		return fmt.Errorf("invalid descriptor set: unable to parse the FileDescriptorSet")
+	}
+	files, err := protodesc.NewFiles(fds)
+	if err != nil {
+		return fmt.Errorf("invalid descriptor set: unable to resolve the proto files, the imports must be included")
+	}
+
+	if len(services) == 0 {
+		return fmt.Errorf("at least one service must be specified")
+	}
+	for _, service := range services {
+		desc, err := files.FindDescriptorByName(protoreflect.FullName(service))
+		if err != nil {
+			return fmt.Errorf("service %s not found in the descriptor set", service)
+		}
+		if _, ok := desc.(protoreflect.ServiceDescriptor); !ok {
+			return fmt.Errorf("%s is not a service", service)
+		}
+	}
+	return nil
+}
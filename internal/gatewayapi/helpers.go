@@ -215,6 +215,9 @@ func ValidateHTTPRouteFilter(filter *v1beta1.HTTPRouteFilter, extGKs ...schema.G
 		case string(filter.ExtensionRef.Group) == egv1a1.GroupVersion.Group &&
 			string(filter.ExtensionRef.Kind) == egv1a1.KindRateLimitFilter:
 			return nil
+		case string(filter.ExtensionRef.Group) == egv1a1.GroupVersion.Group &&
+			string(filter.ExtensionRef.Kind) == egv1a1.KindHTTPRouteFilter:
+			return nil
 		default:
 			for _, gk := range extGKs {
 				if filter.ExtensionRef.Group == v1beta1.Group(gk.Group) &&
@@ -237,6 +240,14 @@ func IsAuthnHTTPFilter(filter *v1beta1.HTTPRouteFilter) bool {
 		string(filter.ExtensionRef.Kind) == egv1a1.KindAuthenticationFilter
 }
 
+// IsHTTPRouteFilter returns true if the provided filter is an Envoy Gateway HTTPRouteFilter.
+func IsHTTPRouteFilter(filter *v1beta1.HTTPRouteFilter) bool {
+	return filter.Type == v1beta1.HTTPRouteFilterExtensionRef &&
+		filter.ExtensionRef != nil &&
+		string(filter.ExtensionRef.Group) == egv1a1.GroupVersion.Group &&
+		string(filter.ExtensionRef.Kind) == egv1a1.KindHTTPRouteFilter
+}
+
 // IsRateLimitHTTPFilter returns true if the provided filter is a RateLimitFilter.
 func IsRateLimitHTTPFilter(filter *v1beta1.HTTPRouteFilter) bool {
 	return filter.Type == v1beta1.HTTPRouteFilterExtensionRef &&
@@ -264,6 +275,9 @@ func ValidateGRPCRouteFilter(filter *v1alpha2.GRPCRouteFilter, extGKs ...schema.
 		case string(filter.ExtensionRef.Group) == egv1a1.GroupVersion.Group &&
 			string(filter.ExtensionRef.Kind) == egv1a1.KindRateLimitFilter:
 			return nil
+		case string(filter.ExtensionRef.Group) == egv1a1.GroupVersion.Group &&
+			string(filter.ExtensionRef.Kind) == egv1a1.KindHTTPRouteFilter:
+			return nil
 		default:
 			for _, gk := range extGKs {
 				if filter.ExtensionRef.Group == v1beta1.Group(gk.Group) &&
@@ -440,6 +454,11 @@ func irRouteName(route RouteContext, ruleIdx, matchIdx int) string {
 	return fmt.Sprintf("%s/%s/%s/rule/%d/match/%d", strings.ToLower(string(GetRouteType(route))), route.GetNamespace(), route.GetName(), ruleIdx, matchIdx)
 }
 
+// irRoutePrefix returns the prefix of the names of the IR routes created for a route.
+func irRoutePrefix(route RouteContext) string {
+	return fmt.Sprintf("%s/%s/%s/", strings.ToLower(string(GetRouteType(route))), route.GetNamespace(), route.GetName())
+}
+
 func irRouteDestinationName(route RouteContext, ruleIdx int) string {
 	return fmt.Sprintf("%s/%s/%s/rule/%d", strings.ToLower(string(GetRouteType(route))), route.GetNamespace(), route.GetName(), ruleIdx)
 }
@@ -164,6 +164,18 @@ func TestValidateGRPCFilterRef(t *testing.T) {
 			},
 			expected: true,
 		},
+		{
+			name: "valid httproutefilter",
+			filter: &gwapiv1a2.GRPCRouteFilter{
+				Type: gwapiv1a2.GRPCRouteFilterExtensionRef,
+				ExtensionRef: &gwapiv1b1.LocalObjectReference{
+					Group: gwapiv1b1.Group(egv1a1.GroupVersion.Group),
+					Kind:  egv1a1.KindHTTPRouteFilter,
+					Name:  "test",
+				},
+			},
+			expected: true,
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
@@ -0,0 +1,426 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package gatewayapi
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	gwv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/status"
+)
+
+// ProcessL4TrafficPolicies applies the L4TrafficPolicies to the TCP and UDP listeners of the xds IR.
+// The policies attached to a Gateway are applied first, then the policies attached to a listener of
+// a Gateway, then the policies attached to a TCPRoute, a TLSRoute or a UDPRoute, so that the most
+// specific policy takes precedence.
+func (t *Translator) ProcessL4TrafficPolicies(l4TrafficPolicies []*egv1a1.L4TrafficPolicy,
+	gateways []*GatewayContext,
+	tcpRoutes []*TCPRouteContext,
+	tlsRoutes []*TLSRouteContext,
+	udpRoutes []*UDPRouteContext,
+	xdsIR XdsIRMap) []*egv1a1.L4TrafficPolicy {
+	var res []*egv1a1.L4TrafficPolicy
+
+	// Sort based on creation timestamp, the oldest policy wins when several
+	// policies target the same resource.
+	sort.Slice(l4TrafficPolicies, func(i, j int) bool {
+		if l4TrafficPolicies[i].CreationTimestamp.Equal(&(l4TrafficPolicies[j].CreationTimestamp)) {
+			return l4TrafficPolicies[i].Name < l4TrafficPolicies[j].Name
+		}
+		return l4TrafficPolicies[i].CreationTimestamp.Before(&(l4TrafficPolicies[j].CreationTimestamp))
+	})
+
+	gatewayMap := make(map[types.NamespacedName]*GatewayContext, len(gateways))
+	for _, gateway := range gateways {
+		gatewayMap[types.NamespacedName{Namespace: gateway.Namespace, Name: gateway.Name}] = gateway
+	}
+	tcpRouteMap := make(map[types.NamespacedName]*TCPRouteContext, len(tcpRoutes))
+	for _, tcpRoute := range tcpRoutes {
+		tcpRouteMap[types.NamespacedName{Namespace: tcpRoute.Namespace, Name: tcpRoute.Name}] = tcpRoute
+	}
+	tlsRouteMap := make(map[types.NamespacedName]*TLSRouteContext, len(tlsRoutes))
+	for _, tlsRoute := range tlsRoutes {
+		tlsRouteMap[types.NamespacedName{Namespace: tlsRoute.Namespace, Name: tlsRoute.Name}] = tlsRoute
+	}
+	udpRouteMap := make(map[types.NamespacedName]*UDPRouteContext, len(udpRoutes))
+	for _, udpRoute := range udpRoutes {
+		udpRouteMap[types.NamespacedName{Namespace: udpRoute.Namespace, Name: udpRoute.Name}] = udpRoute
+	}
+
+	// Policies are handled in three passes, Gateway targets first, then listener
+	// targets, then Route targets.
+	var gatewayPolicies, listenerPolicies, routePolicies []*egv1a1.L4TrafficPolicy
+	for _, policy := range l4TrafficPolicies {
+		policy := policy.DeepCopy()
+		res = append(res, policy)
+
+		targetRef := policy.Spec.TargetRef
+		if targetRef.Group != gwv1b1.GroupName ||
+			(targetRef.Kind != KindGateway && targetRef.Kind != KindTCPRoute &&
+				targetRef.Kind != KindTLSRoute && targetRef.Kind != KindUDPRoute) {
+			message := fmt.Sprintf("TargetRef.Group:%s TargetRef.Kind:%s, only TargetRef.Group:%s and TargetRef.Kind:%s, %s, %s or %s are supported.",
+				targetRef.Group, targetRef.Kind, gwv1b1.GroupName, KindGateway, KindTCPRoute, KindTLSRoute, KindUDPRoute)
+
+			status.SetL4TrafficPolicyCondition(policy,
+				gwv1a2.PolicyConditionAccepted,
+				metav1.ConditionFalse,
+				gwv1a2.PolicyReasonInvalid,
+				message,
+			)
+			continue
+		}
+
+		// Ensure Policy and target are in the same namespace
+		targetNs := NamespaceDerefOr(targetRef.Namespace, policy.Namespace)
+		if policy.Namespace != targetNs {
+			message := fmt.Sprintf("Namespace:%s TargetRef.Namespace:%s, L4TrafficPolicy can only target a resource in the same namespace.",
+				policy.Namespace, targetNs)
+
+			status.SetL4TrafficPolicyCondition(policy,
+				gwv1a2.PolicyConditionAccepted,
+				metav1.ConditionFalse,
+				gwv1a2.PolicyReasonInvalid,
+				message,
+			)
+			continue
+		}
+
+		if targetRef.SectionName != nil && targetRef.Kind != KindGateway {
+			message := fmt.Sprintf("TargetRef.Kind:%s TargetRef.SectionName:%s, the SectionName can only be set when targeting a %s.",
+				targetRef.Kind, *targetRef.SectionName, KindGateway)
+
+			status.SetL4TrafficPolicyCondition(policy,
+				gwv1a2.PolicyConditionAccepted,
+				metav1.ConditionFalse,
+				gwv1a2.PolicyReasonInvalid,
+				message,
+			)
+			continue
+		}
+
+		switch {
+		case targetRef.Kind != KindGateway:
+			routePolicies = append(routePolicies, policy)
+		case targetRef.SectionName != nil:
+			listenerPolicies = append(listenerPolicies, policy)
+		default:
+			gatewayPolicies = append(gatewayPolicies, policy)
+		}
+	}
+
+	handledTargets := make(map[string]*egv1a1.L4TrafficPolicy)
+	for _, policy := range append(append(gatewayPolicies, listenerPolicies...), routePolicies...) {
+		targetRef := policy.Spec.TargetRef
+		key := types.NamespacedName{Namespace: policy.Namespace, Name: string(targetRef.Name)}
+		target := fmt.Sprintf("%s/%s", targetRef.Kind, key)
+
+		var (
+			listener     *ListenerContext
+			tcpListeners []*ir.TCPListener
+			udpListeners []*ir.UDPListener
+			found        bool
+			targetMsg    = fmt.Sprintf("%s:%s", targetRef.Kind, targetRef.Name)
+		)
+		switch targetRef.Kind {
+		case KindGateway:
+			var gateway *GatewayContext
+			gateway, found = gatewayMap[key]
+			if found && targetRef.SectionName != nil {
+				target = fmt.Sprintf("%s/%s", target, *targetRef.SectionName)
+				targetMsg = fmt.Sprintf("%s SectionName:%s", targetMsg, *targetRef.SectionName)
+				listener = getGatewayListener(gateway, *targetRef.SectionName)
+				found = listener != nil
+			}
+			if found {
+				tcpListeners, udpListeners = getGatewayIRL4Listeners(gateway, listener, xdsIR)
+			}
+		case KindTCPRoute:
+			var tcpRoute *TCPRouteContext
+			if tcpRoute, found = tcpRouteMap[key]; found {
+				tcpListeners = getTCPRouteIRListeners(tcpRoute, xdsIR)
+			}
+		case KindTLSRoute:
+			var tlsRoute *TLSRouteContext
+			if tlsRoute, found = tlsRouteMap[key]; found {
+				tcpListeners = getTLSRouteIRListeners(tlsRoute, xdsIR)
+			}
+		case KindUDPRoute:
+			var udpRoute *UDPRouteContext
+			if udpRoute, found = udpRouteMap[key]; found {
+				udpListeners = getUDPRouteIRListeners(udpRoute, xdsIR)
+			}
+		}
+
+		if !found {
+			message := fmt.Sprintf("%s not found.", targetMsg)
+
+			status.SetL4TrafficPolicyCondition(policy,
+				gwv1a2.PolicyConditionAccepted,
+				metav1.ConditionFalse,
+				gwv1a2.PolicyReasonTargetNotFound,
+				message,
+			)
+			continue
+		}
+
+		// Ensure the target is not already targeted by another policy
+		if winner, ok := handledTargets[target]; ok {
+			message := fmt.Sprintf("%s is already targeted by L4TrafficPolicy:%s.", targetMsg, winner.Name)
+
+			status.SetL4TrafficPolicyCondition(policy,
+				gwv1a2.PolicyConditionAccepted,
+				metav1.ConditionFalse,
+				gwv1a2.PolicyReasonConflicted,
+				message,
+			)
+			continue
+		}
+
+		tcpSettings, udpSettings, err := t.buildL4ProxySettings(policy, listener)
+		if err != nil {
+			status.SetL4TrafficPolicyCondition(policy,
+				gwv1a2.PolicyConditionAccepted,
+				metav1.ConditionFalse,
+				gwv1a2.PolicyReasonInvalid,
+				err.Error(),
+			)
+			continue
+		}
+		handledTargets[target] = policy
+
+		if tcpSettings != nil {
+			for _, listener := range tcpListeners {
+				listener.ProxySettings = tcpSettings
+			}
+		}
+		if udpSettings != nil {
+			for _, listener := range udpListeners {
+				listener.ProxySettings = udpSettings
+			}
+		}
+
+		// Set Accepted=True
+		status.SetL4TrafficPolicyCondition(policy,
+			gwv1a2.PolicyConditionAccepted,
+			metav1.ConditionTrue,
+			gwv1a2.PolicyReasonAccepted,
+			"L4TrafficPolicy has been accepted.",
+		)
+	}
+
+	return res
+}
+
+// getGatewayIRL4Listeners returns the TCP and UDP IR listeners of the Gateway, or only
+// the ones created for the listener of the Gateway if it is set. The TCP and UDP IR
+// listeners are named after the listener of the Gateway followed by the name of their
+// route.
+func getGatewayIRL4Listeners(gateway *GatewayContext, listener *ListenerContext, xdsIR XdsIRMap) ([]*ir.TCPListener, []*ir.UDPListener) {
+	gwXdsIR, ok := xdsIR[irStringKey(gateway.Namespace, gateway.Name)]
+	if !ok {
+		return nil, nil
+	}
+	if listener == nil {
+		return gwXdsIR.TCP, gwXdsIR.UDP
+	}
+
+	prefix := irHTTPListenerName(listener) + "/"
+	var (
+		tcpListeners []*ir.TCPListener
+		udpListeners []*ir.UDPListener
+	)
+	for _, irListener := range gwXdsIR.TCP {
+		if strings.HasPrefix(irListener.Name, prefix) {
+			tcpListeners = append(tcpListeners, irListener)
+		}
+	}
+	for _, irListener := range gwXdsIR.UDP {
+		if strings.HasPrefix(irListener.Name, prefix) {
+			udpListeners = append(udpListeners, irListener)
+		}
+	}
+	return tcpListeners, udpListeners
+}
+
+// getTCPRouteIRListeners returns the IR listeners created for a TCPRoute.
+func getTCPRouteIRListeners(tcpRoute *TCPRouteContext, xdsIR XdsIRMap) []*ir.TCPListener {
+	var listeners []*ir.TCPListener
+	for _, parentRef := range tcpRoute.ParentRefs {
+		for _, listener := range parentRef.listeners {
+			gwXdsIR, ok := xdsIR[irStringKey(listener.gateway.Namespace, listener.gateway.Name)]
+			if !ok {
+				continue
+			}
+			name := irTCPListenerName(listener, tcpRoute)
+			for _, irListener := range gwXdsIR.TCP {
+				if irListener.Name == name {
+					listeners = append(listeners, irListener)
+				}
+			}
+		}
+	}
+	return listeners
+}
+
+// getTLSRouteIRListeners returns the IR listeners created for a TLSRoute.
+func getTLSRouteIRListeners(tlsRoute *TLSRouteContext, xdsIR XdsIRMap) []*ir.TCPListener {
+	var listeners []*ir.TCPListener
+	for _, parentRef := range tlsRoute.ParentRefs {
+		for _, listener := range parentRef.listeners {
+			gwXdsIR, ok := xdsIR[irStringKey(listener.gateway.Namespace, listener.gateway.Name)]
+			if !ok {
+				continue
+			}
+			name := irTLSListenerName(listener, tlsRoute)
+			for _, irListener := range gwXdsIR.TCP {
+				if irListener.Name == name {
+					listeners = append(listeners, irListener)
+				}
+			}
+		}
+	}
+	return listeners
+}
+
+// getUDPRouteIRListeners returns the IR listeners created for a UDPRoute.
+func getUDPRouteIRListeners(udpRoute *UDPRouteContext, xdsIR XdsIRMap) []*ir.UDPListener {
+	var listeners []*ir.UDPListener
+	for _, parentRef := range udpRoute.ParentRefs {
+		for _, listener := range parentRef.listeners {
+			gwXdsIR, ok := xdsIR[irStringKey(listener.gateway.Namespace, listener.gateway.Name)]
+			if !ok {
+				continue
+			}
+			name := irUDPListenerName(listener, udpRoute)
+			for _, irListener := range gwXdsIR.UDP {
+				if irListener.Name == name {
+					listeners = append(listeners, irListener)
+				}
+			}
+		}
+	}
+	return listeners
+}
+
+// buildL4ProxySettings translates the settings of the policy, ensuring they can
+// be applied to the target, i.e. the listener if the policy targets a listener.
+func (t *Translator) buildL4ProxySettings(policy *egv1a1.L4TrafficPolicy, listener *ListenerContext) (*ir.TCPProxySettings, *ir.UDPProxySettings, error) {
+	spec := policy.Spec
+	switch {
+	case listener != nil:
+		switch listener.Protocol {
+		case gwv1b1.TCPProtocolType, gwv1b1.TLSProtocolType:
+			if spec.UDP != nil {
+				return nil, nil, fmt.Errorf("udp settings can't be applied to a %s listener", listener.Protocol)
+			}
+		case gwv1b1.UDPProtocolType:
+			if spec.TCP != nil {
+				return nil, nil, fmt.Errorf("tcp settings can't be applied to a %s listener", listener.Protocol)
+			}
+		default:
+			return nil, nil, fmt.Errorf("only the TCP, TLS and UDP listeners can be targeted, %s is a %s listener",
+				listener.Name, listener.Protocol)
+		}
+	case spec.TCP != nil && spec.TargetRef.Kind == KindUDPRoute:
+		return nil, nil, fmt.Errorf("tcp settings can't be applied to a %s", KindUDPRoute)
+	case spec.UDP != nil && (spec.TargetRef.Kind == KindTCPRoute || spec.TargetRef.Kind == KindTLSRoute):
+		return nil, nil, fmt.Errorf("udp settings can't be applied to a %s", spec.TargetRef.Kind)
+	}
+
+	var tcpSettings *ir.TCPProxySettings
+	if spec.TCP != nil {
+		tcpSettings = &ir.TCPProxySettings{
+			IdleTimeout:           spec.TCP.IdleTimeout,
+			MaxConnectionDuration: spec.TCP.MaxConnectionDuration,
+			MaxConnectAttempts:    spec.TCP.MaxConnectAttempts,
+		}
+		if spec.TCP.AccessLog != nil {
+			tcpSettings.AccessLogFlushInterval = spec.TCP.AccessLog.FlushInterval
+			if spec.TCP.AccessLog.FlushOnConnected != nil {
+				tcpSettings.FlushAccessLogOnConnected = *spec.TCP.AccessLog.FlushOnConnected
+			}
+		}
+		if d := tcpSettings.IdleTimeout; d != nil && d.Duration < 0 {
+			return nil, nil, fmt.Errorf("tcp.idleTimeout must not be negative")
+		}
+		if d := tcpSettings.MaxConnectionDuration; d != nil && d.Duration < 0 {
+			return nil, nil, fmt.Errorf("tcp.maxConnectionDuration must not be negative")
+		}
+		if tcpSettings.AccessLogFlushInterval != nil && tcpSettings.AccessLogFlushInterval.Duration < time.Millisecond {
+			return nil, nil, fmt.Errorf("tcp.accessLog.flushInterval must be at least 1ms")
+		}
+		if spec.TCP.RateLimit != nil {
+			rateLimit, err := t.buildTCPRateLimit(spec.TCP.RateLimit)
+			if err != nil {
+				return nil, nil, err
+			}
+			tcpSettings.RateLimit = rateLimit
+		}
+	}
+
+	var udpSettings *ir.UDPProxySettings
+	if spec.UDP != nil {
+		udpSettings = &ir.UDPProxySettings{
+			SessionIdleTimeout: spec.UDP.SessionIdleTimeout,
+		}
+		if udpSettings.SessionIdleTimeout != nil && udpSettings.SessionIdleTimeout.Duration < 0 {
+			return nil, nil, fmt.Errorf("udp.sessionIdleTimeout must not be negative")
+		}
+	}
+
+	return tcpSettings, udpSettings, nil
+}
+
+// buildTCPRateLimit translates the rate limits of the new connections of a L4TrafficPolicy.
+func (t *Translator) buildTCPRateLimit(rateLimit *egv1a1.TCPRateLimit) (*ir.TCPRateLimit, error) {
+	switch rateLimit.Type {
+	case egv1a1.GlobalRateLimitType:
+		if rateLimit.Global == nil || len(rateLimit.Global.Rules) == 0 {
+			return nil, fmt.Errorf("tcp.rateLimit.global must be set for the Global type")
+		}
+		if !t.GlobalRateLimitEnabled {
+			return nil, fmt.Errorf("tcp.rateLimit.global requires the rate limit service to be enabled")
+		}
+		rules := make([]*ir.TCPRateLimitRule, 0, len(rateLimit.Global.Rules))
+		for _, rule := range rateLimit.Global.Rules {
+			if rule.Limit.UnitMultiplier != nil && *rule.Limit.UnitMultiplier > 1 {
+				return nil, fmt.Errorf("tcp.rateLimit.global doesn't support unit multipliers")
+			}
+			rules = append(rules, &ir.TCPRateLimitRule{
+				Distinct: rule.SourceIP != nil && *rule.SourceIP == egv1a1.SourceMatchDistinct,
+				Limit:    buildTCPRateLimitValue(rule.Limit),
+			})
+		}
+		return &ir.TCPRateLimit{Global: rules}, nil
+	case egv1a1.LocalRateLimitType:
+		if rateLimit.Local == nil {
+			return nil, fmt.Errorf("tcp.rateLimit.local must be set for the Local type")
+		}
+		return &ir.TCPRateLimit{Local: buildTCPRateLimitValue(rateLimit.Local.Limit)}, nil
+	default:
+		return nil, fmt.Errorf("unsupported tcp.rateLimit type %s", rateLimit.Type)
+	}
+}
+
+func buildTCPRateLimitValue(limit egv1a1.RateLimitValue) *ir.RateLimitValue {
+	value := &ir.RateLimitValue{
+		Requests: limit.Requests,
+		Unit:     ir.RateLimitUnit(limit.Unit),
+	}
+	if limit.UnitMultiplier != nil {
+		value.UnitMultiplier = *limit.UnitMultiplier
+	}
+	return value
+}
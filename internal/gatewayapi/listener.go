@@ -17,6 +17,9 @@ import (
 	"github.com/envoyproxy/gateway/internal/utils/naming"
 )
 
+// defaultALSLogName is the log name of the ALS access logs, if unspecified.
+const defaultALSLogName = "envoy-gateway"
+
 var _ ListenersTranslator = (*Translator)(nil)
 
 type ListenersTranslator interface {
@@ -49,9 +52,9 @@ func (t *Translator) ProcessListeners(gateways []*GatewayContext, xdsIR XdsIRMap
 		// Infra IR proxy ports must be unique.
 		var foundPorts []*protocolPort
 
-		gwXdsIR.AccessLog = processAccessLog(gwInfraIR.Proxy.Config)
 		gwXdsIR.Tracing = processTracing(gateway.Gateway, gwInfraIR.Proxy.Config)
 		gwXdsIR.Metrics = processMetrics(gwInfraIR.Proxy.Config)
+		gwXdsIR.ConnectionLimit = processConnectionLimit(gwInfraIR.Proxy.Config)
 
 		for _, listener := range gateway.listeners {
 			// Process protocol & supported kinds
@@ -153,9 +156,24 @@ func (t *Translator) ProcessListeners(gateways []*GatewayContext, xdsIR XdsIRMap
 }
 
 func processAccessLog(envoyproxy *configv1a1.EnvoyProxy) *ir.AccessLog {
-	if envoyproxy == nil ||
-		envoyproxy.Spec.Telemetry.AccessLog == nil ||
-		(!envoyproxy.Spec.Telemetry.AccessLog.Disable && len(envoyproxy.Spec.Telemetry.AccessLog.Settings) == 0) {
+	if envoyproxy == nil || envoyproxy.Spec.Telemetry.AccessLog == nil {
+		return processAccessLogSettings("", "", nil)
+	}
+
+	if envoyproxy.Spec.Telemetry.AccessLog.Disable {
+		return nil
+	}
+
+	return processAccessLogSettings(fmt.Sprintf("envoyproxy/%s/%s", envoyproxy.Namespace, envoyproxy.Name),
+		envoyproxy.Namespace, envoyproxy.Spec.Telemetry.AccessLog.Settings)
+}
+
+// processAccessLogSettings translates the access log settings to the IR, the
+// default access log is used if there are no settings. The name of the owner
+// of the settings prefixes the names of the filters, and the namespace is the
+// default namespace of the backends of the sinks.
+func processAccessLogSettings(owner, namespace string, settings []configv1a1.ProxyAccessLogSetting) *ir.AccessLog {
+	if len(settings) == 0 {
 		// use the default access log
 		return &ir.AccessLog{
 			Text: []*ir.TextAccessLog{
@@ -166,13 +184,10 @@ func processAccessLog(envoyproxy *configv1a1.EnvoyProxy) *ir.AccessLog {
 		}
 	}
 
-	if envoyproxy.Spec.Telemetry.AccessLog.Disable {
-		return nil
-	}
-
 	irAccessLog := &ir.AccessLog{}
 	// translate the access log configuration to the IR
-	for _, accessLog := range envoyproxy.Spec.Telemetry.AccessLog.Settings {
+	for i, accessLog := range settings {
+		filter := processAccessLogFilter(fmt.Sprintf("%s/setting/%d", owner, i), accessLog.Filter)
 		for _, sink := range accessLog.Sinks {
 			switch sink.Type {
 			case configv1a1.ProxyAccessLogSinkTypeFile:
@@ -185,6 +200,7 @@ func processAccessLog(envoyproxy *configv1a1.EnvoyProxy) *ir.AccessLog {
 					al := &ir.TextAccessLog{
 						Format: accessLog.Format.Text,
 						Path:   sink.File.Path,
+						Filter: filter,
 					}
 					irAccessLog.Text = append(irAccessLog.Text, al)
 				case configv1a1.ProxyAccessLogFormatTypeJSON:
@@ -194,8 +210,9 @@ func processAccessLog(envoyproxy *configv1a1.EnvoyProxy) *ir.AccessLog {
 					}
 
 					al := &ir.JSONAccessLog{
-						JSON: accessLog.Format.JSON,
-						Path: sink.File.Path,
+						JSON:   accessLog.Format.JSON,
+						Path:   sink.File.Path,
+						Filter: filter,
 					}
 					irAccessLog.JSON = append(irAccessLog.JSON, al)
 				}
@@ -208,6 +225,7 @@ func processAccessLog(envoyproxy *configv1a1.EnvoyProxy) *ir.AccessLog {
 					Port:      uint32(sink.OpenTelemetry.Port),
 					Host:      sink.OpenTelemetry.Host,
 					Resources: sink.OpenTelemetry.Resources,
+					Filter:    filter,
 				}
 
 				switch accessLog.Format.Type {
@@ -218,6 +236,32 @@ func processAccessLog(envoyproxy *configv1a1.EnvoyProxy) *ir.AccessLog {
 				}
 
 				irAccessLog.OpenTelemetry = append(irAccessLog.OpenTelemetry, al)
+			case configv1a1.ProxyAccessLogSinkTypeALS:
+				if sink.ALS == nil {
+					continue
+				}
+
+				irAccessLog.ALS = append(irAccessLog.ALS, processALSAccessLog(namespace, sink.ALS, filter))
+			case configv1a1.ProxyAccessLogSinkTypeStdout, configv1a1.ProxyAccessLogSinkTypeStderr:
+				al := &ir.StreamAccessLog{
+					Type:   ir.StreamAccessLogTypeStdout,
+					Filter: filter,
+				}
+				if sink.Type == configv1a1.ProxyAccessLogSinkTypeStderr {
+					al.Type = ir.StreamAccessLogTypeStderr
+				}
+
+				switch accessLog.Format.Type {
+				case configv1a1.ProxyAccessLogFormatTypeText:
+					al.Text = accessLog.Format.Text
+				case configv1a1.ProxyAccessLogFormatTypeJSON:
+					if len(accessLog.Format.JSON) == 0 {
+						continue
+					}
+					al.JSON = accessLog.Format.JSON
+				}
+
+				irAccessLog.Stream = append(irAccessLog.Stream, al)
 			}
 		}
 	}
@@ -225,14 +269,107 @@ func processAccessLog(envoyproxy *configv1a1.EnvoyProxy) *ir.AccessLog {
 	return irAccessLog
 }
 
+// processALSAccessLog translates the ALS sink to the IR. The Service of the ALS
+// is resolved by Envoy with DNS, relying on the search domains of the pod.
+func processALSAccessLog(namespace string, als *configv1a1.ALSEnvoyProxyAccessLog, filter *ir.AccessLogFilter) *ir.ALSAccessLog {
+	if als.BackendRef.Namespace != nil {
+		namespace = string(*als.BackendRef.Namespace)
+	}
+
+	irALS := &ir.ALSAccessLog{
+		LogName:             defaultALSLogName,
+		Type:                ir.ALSAccessLogTypeHTTP,
+		Host:                fmt.Sprintf("%s.%s.svc", als.BackendRef.Name, namespace),
+		BufferFlushInterval: als.BufferFlushInterval,
+		BufferSizeBytes:     als.BufferSizeBytes,
+		Filter:              filter,
+	}
+	if als.BackendRef.Port != nil {
+		irALS.Port = uint32(*als.BackendRef.Port)
+	}
+	if als.LogName != nil {
+		irALS.LogName = *als.LogName
+	}
+	if als.Type != nil && *als.Type == configv1a1.ALSEnvoyProxyAccessLogTypeTCP {
+		irALS.Type = ir.ALSAccessLogTypeTCP
+	}
+	if als.HTTP != nil {
+		irALS.HTTP = &ir.ALSAccessLogHTTPConfig{
+			RequestHeaders:   als.HTTP.RequestHeaders,
+			ResponseHeaders:  als.HTTP.ResponseHeaders,
+			ResponseTrailers: als.HTTP.ResponseTrailers,
+		}
+	}
+
+	return irALS
+}
+
+func processAccessLogFilter(name string, filter *configv1a1.ProxyAccessLogFilter) *ir.AccessLogFilter {
+	if filter == nil || len(filter.Conditions) == 0 {
+		return nil
+	}
+
+	irFilter := &ir.AccessLogFilter{
+		Name:       name,
+		Or:         filter.Operator != nil && *filter.Operator == configv1a1.ProxyAccessLogFilterOperatorOr,
+		Conditions: make([]*ir.AccessLogFilterCondition, 0, len(filter.Conditions)),
+	}
+	for _, condition := range filter.Conditions {
+		irCondition := &ir.AccessLogFilterCondition{
+			MinDuration:   condition.MinDuration,
+			SamplePercent: condition.SamplePercent,
+		}
+		if condition.StatusCode != nil {
+			irCondition.StatusCode = &ir.StatusCodeRange{}
+			if condition.StatusCode.Min != nil {
+				irCondition.StatusCode.Min = *condition.StatusCode.Min
+			}
+			if condition.StatusCode.Max != nil {
+				irCondition.StatusCode.Max = *condition.StatusCode.Max
+			}
+		}
+		for _, flag := range condition.ResponseFlags {
+			irCondition.ResponseFlags = append(irCondition.ResponseFlags, string(flag))
+		}
+		if condition.Header != nil {
+			irCondition.Header = &ir.AccessLogHeaderMatch{
+				Name:  condition.Header.Name,
+				Value: condition.Header.Value,
+			}
+		}
+		irFilter.Conditions = append(irFilter.Conditions, irCondition)
+	}
+
+	return irFilter
+}
+
 func processTracing(gw *v1beta1.Gateway, envoyproxy *configv1a1.EnvoyProxy) *ir.Tracing {
 	if envoyproxy == nil || envoyproxy.Spec.Telemetry.Tracing == nil {
 		return nil
 	}
 
+	tracing := envoyproxy.Spec.Telemetry.Tracing.DeepCopy()
+	if tracing.Provider.Port == 0 {
+		tracing.Provider.Port = defaultTracingProviderPort(tracing.Provider.Type)
+	}
+
 	return &ir.Tracing{
 		ServiceName:  naming.ServiceName(types.NamespacedName{Name: gw.Name, Namespace: gw.Namespace}),
-		ProxyTracing: *envoyproxy.Spec.Telemetry.Tracing,
+		ProxyTracing: *tracing,
+	}
+}
+
+// defaultTracingProviderPort returns the port the Zipkin collector or the Datadog
+// agent receives the spans on, if unspecified. The port of the OpenTelemetry
+// collector is left unchanged, it is defaulted by the xds translator.
+func defaultTracingProviderPort(providerType configv1a1.TracingProviderType) int32 {
+	switch providerType {
+	case configv1a1.TracingProviderTypeZipkin:
+		return 9411
+	case configv1a1.TracingProviderTypeDatadog:
+		return 8126
+	default:
+		return 0
 	}
 }
 
@@ -244,3 +381,12 @@ func processMetrics(envoyproxy *configv1a1.EnvoyProxy) *ir.Metrics {
 		EnableVirtualHostStats: envoyproxy.Spec.Telemetry.Metrics.EnableVirtualHostStats,
 	}
 }
+
+func processConnectionLimit(envoyproxy *configv1a1.EnvoyProxy) *ir.ConnectionLimit {
+	if envoyproxy == nil || envoyproxy.Spec.Overload == nil || envoyproxy.Spec.Overload.MaxListenerConnections == nil {
+		return nil
+	}
+	return &ir.ConnectionLimit{
+		MaxConnections: *envoyproxy.Spec.Overload.MaxListenerConnections,
+	}
+}
@@ -43,6 +43,36 @@ func TestProcessTracing(t *testing.T) {
 				ProxyTracing: egcfgv1a1.ProxyTracing{},
 			},
 		},
+		{
+			gw: v1beta1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "fake-gw",
+					Namespace: "fake-ns",
+				},
+			},
+			proxy: &egcfgv1a1.EnvoyProxy{
+				Spec: egcfgv1a1.EnvoyProxySpec{
+					Telemetry: egcfgv1a1.ProxyTelemetry{
+						Tracing: &egcfgv1a1.ProxyTracing{
+							Provider: egcfgv1a1.TracingProvider{
+								Type: egcfgv1a1.TracingProviderTypeDatadog,
+								Host: "datadog-agent",
+							},
+						},
+					},
+				},
+			},
+			expected: &ir.Tracing{
+				ServiceName: "fake-gw.fake-ns",
+				ProxyTracing: egcfgv1a1.ProxyTracing{
+					Provider: egcfgv1a1.TracingProvider{
+						Type: egcfgv1a1.TracingProviderTypeDatadog,
+						Host: "datadog-agent",
+						Port: 8126,
+					},
+				},
+			},
+		},
 	}
 
 	for _, c := range cases {
@@ -0,0 +1,201 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package gatewayapi
+
+import (
+	"fmt"
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	gwv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/status"
+)
+
+func (t *Translator) ProcessLocalReplyPolicies(localReplyPolicies []*egv1a1.LocalReplyPolicy,
+	gateways []*GatewayContext,
+	resources *Resources,
+	xdsIR XdsIRMap) []*egv1a1.LocalReplyPolicy {
+	var res []*egv1a1.LocalReplyPolicy
+
+	// Sort based on creation timestamp, the oldest policy wins when several
+	// policies target the same Gateway.
+	sort.Slice(localReplyPolicies, func(i, j int) bool {
+		if localReplyPolicies[i].CreationTimestamp.Equal(&(localReplyPolicies[j].CreationTimestamp)) {
+			return localReplyPolicies[i].Name < localReplyPolicies[j].Name
+		}
+		return localReplyPolicies[i].CreationTimestamp.Before(&(localReplyPolicies[j].CreationTimestamp))
+	})
+
+	gatewayMap := make(map[types.NamespacedName]*GatewayContext, len(gateways))
+	for _, gateway := range gateways {
+		gatewayMap[types.NamespacedName{Namespace: gateway.Namespace, Name: gateway.Name}] = gateway
+	}
+	handledGateways := make(map[types.NamespacedName]*egv1a1.LocalReplyPolicy)
+
+	for _, policy := range localReplyPolicies {
+		policy := policy.DeepCopy()
+		targetRef := policy.Spec.TargetRef
+		targetNs := NamespaceDerefOr(targetRef.Namespace, policy.Namespace)
+		key := types.NamespacedName{Namespace: targetNs, Name: string(targetRef.Name)}
+
+		res = append(res, policy)
+
+		// Ensure policy can only target a Gateway
+		if targetRef.Group != gwv1b1.GroupName || targetRef.Kind != KindGateway {
+			message := fmt.Sprintf("TargetRef.Group:%s TargetRef.Kind:%s, only TargetRef.Group:%s and TargetRef.Kind:%s is supported.",
+				targetRef.Group, targetRef.Kind, gwv1b1.GroupName, KindGateway)
+
+			status.SetLocalReplyPolicyCondition(policy,
+				gwv1a2.PolicyConditionAccepted,
+				metav1.ConditionFalse,
+				gwv1a2.PolicyReasonInvalid,
+				message,
+			)
+			continue
+		}
+
+		// Ensure Policy and target Gateway are in the same namespace
+		if policy.Namespace != targetNs {
+			message := fmt.Sprintf("Namespace:%s TargetRef.Namespace:%s, LocalReplyPolicy can only target a Gateway in the same namespace.",
+				policy.Namespace, targetNs)
+
+			status.SetLocalReplyPolicyCondition(policy,
+				gwv1a2.PolicyConditionAccepted,
+				metav1.ConditionFalse,
+				gwv1a2.PolicyReasonInvalid,
+				message,
+			)
+			continue
+		}
+
+		gateway, ok := gatewayMap[key]
+		if !ok {
+			message := fmt.Sprintf("Gateway:%s not found.", targetRef.Name)
+
+			status.SetLocalReplyPolicyCondition(policy,
+				gwv1a2.PolicyConditionAccepted,
+				metav1.ConditionFalse,
+				gwv1a2.PolicyReasonTargetNotFound,
+				message,
+			)
+			continue
+		}
+
+		// Ensure the Gateway is not already targeted by another policy
+		if winner, ok := handledGateways[key]; ok {
+			message := fmt.Sprintf("Gateway:%s is already targeted by LocalReplyPolicy:%s.", gateway.Name, winner.Name)
+
+			status.SetLocalReplyPolicyCondition(policy,
+				gwv1a2.PolicyConditionAccepted,
+				metav1.ConditionFalse,
+				gwv1a2.PolicyReasonConflicted,
+				message,
+			)
+			continue
+		}
+
+		localReply, err := buildLocalReply(policy, resources)
+		if err != nil {
+			status.SetLocalReplyPolicyCondition(policy,
+				gwv1a2.PolicyConditionAccepted,
+				metav1.ConditionFalse,
+				gwv1a2.PolicyReasonInvalid,
+				err.Error(),
+			)
+			continue
+		}
+		handledGateways[key] = policy
+
+		// Apply the local reply configuration to all HTTP listeners of the Gateway.
+		irKey := irStringKey(gateway.Namespace, gateway.Name)
+		if gwXdsIR, ok := xdsIR[irKey]; ok {
+			for _, listener := range gwXdsIR.HTTP {
+				listener.LocalReply = localReply
+			}
+		}
+
+		// Set Accepted=True
+		status.SetLocalReplyPolicyCondition(policy,
+			gwv1a2.PolicyConditionAccepted,
+			metav1.ConditionTrue,
+			gwv1a2.PolicyReasonAccepted,
+			"LocalReplyPolicy has been accepted.",
+		)
+	}
+
+	return res
+}
+
+func buildLocalReply(policy *egv1a1.LocalReplyPolicy, resources *Resources) (*ir.LocalReply, error) {
+	localReply := &ir.LocalReply{}
+	for i, mapper := range policy.Spec.Mappers {
+		irMapper := &ir.LocalReplyMapper{
+			Name:          irLocalReplyMapperName(policy, i),
+			ResponseFlags: mapper.Match.ResponseFlags,
+			ContentType:   mapper.ContentType,
+		}
+		for _, code := range mapper.Match.StatusCodes {
+			irMapper.StatusCodes = append(irMapper.StatusCodes, uint32(code))
+		}
+		if mapper.StatusCode != nil {
+			code := uint32(*mapper.StatusCode)
+			irMapper.StatusCode = &code
+		}
+
+		if mapper.Body != nil {
+			body, err := getLocalReplyBody(mapper.Body, policy.Namespace, resources)
+			if err != nil {
+				return nil, fmt.Errorf("mappers[%d]: %w", i, err)
+			}
+			irMapper.Body = body
+		}
+
+		localReply.Mappers = append(localReply.Mappers, irMapper)
+	}
+
+	return localReply, nil
+}
+
+func getLocalReplyBody(body *egv1a1.LocalReplyBody, namespace string, resources *Resources) (*string, error) {
+	switch body.Type {
+	case egv1a1.LocalReplyBodyTypeInline:
+		if body.Inline == nil {
+			return nil, fmt.Errorf("inline must be set for body type %s", body.Type)
+		}
+		return body.Inline, nil
+	case egv1a1.LocalReplyBodyTypeValueRef:
+		if body.ValueRef == nil {
+			return nil, fmt.Errorf("valueRef must be set for body type %s", body.Type)
+		}
+		if body.ValueRef.Group != "" || body.ValueRef.Kind != KindConfigMap {
+			return nil, fmt.Errorf("valueRef.group:%s valueRef.kind:%s, only the core group and kind %s are supported",
+				body.ValueRef.Group, body.ValueRef.Kind, KindConfigMap)
+		}
+		configMap := resources.GetConfigMap(namespace, string(body.ValueRef.Name))
+		if configMap == nil {
+			return nil, fmt.Errorf("ConfigMap %s/%s not found", namespace, body.ValueRef.Name)
+		}
+		data, ok := configMap.Data[egv1a1.LocalReplyBodyConfigMapKey]
+		if !ok {
+			return nil, fmt.Errorf("ConfigMap %s/%s does not contain the %s key",
+				namespace, body.ValueRef.Name, egv1a1.LocalReplyBodyConfigMapKey)
+		}
+		return &data, nil
+	default:
+		return nil, fmt.Errorf("unsupported body type %s", body.Type)
+	}
+}
+
+// irLocalReplyMapperName returns the name of the IR mapper built from the
+// mapper of the policy at the given index.
+func irLocalReplyMapperName(policy *egv1a1.LocalReplyPolicy, index int) string {
+	return fmt.Sprintf("localreplypolicy/%s/%s/mapper/%d", policy.Namespace, policy.Name, index)
+}
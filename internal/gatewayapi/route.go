@@ -9,10 +9,13 @@ import (
 	"fmt"
 	"strings"
 
+	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/gateway-api/apis/v1alpha2"
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
 
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
 	"github.com/envoyproxy/gateway/internal/ir"
 )
 
@@ -144,8 +147,13 @@ func (t *Translator) processHTTPRouteRules(httpRoute *HTTPRouteContext, parentRe
 		// a unique Xds IR HTTPRoute per match.
 		var ruleRoutes = t.processHTTPRouteRule(httpRoute, ruleIdx, httpFiltersContext, rule)
 
+		var backendEndpoints [][]*ir.DestinationEndpoint
 		for _, backendRef := range rule.BackendRefs {
 			endpoints, backendWeight := t.processDestEndpoints(backendRef.BackendRef, parentRef, httpRoute, resources)
+			if !t.processBackendRefFilters(httpBackendRefExtensionRefs(backendRef.Filters), endpoints, parentRef, httpRoute, resources) {
+				endpoints = nil
+			}
+			backendEndpoints = append(backendEndpoints, endpoints)
 			for _, route := range ruleRoutes {
 				// If the route already has a direct response or redirect configured, then it was from a filter so skip
 				// processing any destinations for this route.
@@ -166,6 +174,42 @@ func (t *Translator) processHTTPRouteRules(httpRoute *HTTPRouteContext, parentRe
 			}
 		}
 
+		normalizeEndpointWeights(backendEndpoints)
+
+		// Envoy only rewrites the hostname with the one of the backend for the
+		// backends it resolves with DNS, the rewrite is a no-op otherwise, so
+		// the rule returns a direct response instead.
+		if httpFiltersContext.URLRewrite != nil && httpFiltersContext.URLRewrite.AutoHostname &&
+			len(backendEndpoints) > 0 && !hasHostnameEndpoint(backendEndpoints) {
+			errMsg := fmt.Sprintf("Backend hostname rewrite of rule %d is only supported for backends resolved with DNS, e.g. Backend FQDNs or ExternalName Services.", ruleIdx)
+			parentRef.SetCondition(httpRoute,
+				v1beta1.RouteConditionAccepted,
+				metav1.ConditionFalse,
+				v1beta1.RouteReasonUnsupportedValue,
+				errMsg,
+			)
+			for _, ruleRoute := range ruleRoutes {
+				ruleRoute.Destination = nil
+				ruleRoute.URLRewrite = nil
+				ruleRoute.DirectResponse = &ir.DirectResponse{
+					Body:       &errMsg,
+					StatusCode: 500,
+				}
+			}
+		}
+
+		// The requests of a rule are forwarded over a single protocol, so the
+		// HTTP2 backends of a rule are downgraded to HTTP/1.1 if its other
+		// backends don't speak HTTP2.
+		if hasMixedAppProtocols(backendEndpoints) {
+			parentRef.SetCondition(httpRoute,
+				v1beta1.RouteConditionResolvedRefs,
+				metav1.ConditionFalse,
+				RouteReasonUnsupportedProtocol,
+				fmt.Sprintf("The backends of rule %d declare different application protocols, the requests are forwarded over HTTP/1.1.", ruleIdx),
+			)
+		}
+
 		// If the route has no valid backends then just use a direct response and don't fuss with weighted responses
 		for _, ruleRoute := range ruleRoutes {
 			if ruleRoute.BackendWeights.Invalid > 0 && ruleRoute.Destination == nil {
@@ -257,6 +301,22 @@ func (t *Translator) processHTTPRouteRule(httpRoute *HTTPRouteContext, ruleIdx i
 			})
 		}
 		applyHTTPFiltersContextToIRRoute(httpFiltersContext, irRoute)
+		if irRoute.URLRewrite != nil && irRoute.URLRewrite.Path != nil && irRoute.URLRewrite.Path.RegexMatchReplace != nil {
+			if err := validateRegexRewriteForPathMatch(irRoute.PathMatch, irRoute.URLRewrite.Path.RegexMatchReplace); err != nil {
+				errMsg := fmt.Sprintf("Rewrite path is incompatible with the path match of rule %d match %d: %v", ruleIdx, matchIdx, err)
+				httpFiltersContext.ParentRef.SetCondition(httpRoute,
+					v1beta1.RouteConditionAccepted,
+					metav1.ConditionFalse,
+					v1beta1.RouteReasonUnsupportedValue,
+					errMsg,
+				)
+				irRoute.URLRewrite = nil
+				irRoute.DirectResponse = &ir.DirectResponse{
+					Body:       &errMsg,
+					StatusCode: 500,
+				}
+			}
+		}
 		ruleRoutes = append(ruleRoutes, irRoute)
 	}
 
@@ -286,8 +346,8 @@ func applyHTTPFiltersContextToIRRoute(httpFiltersContext *HTTPFiltersContext, ir
 	if len(httpFiltersContext.RemoveResponseHeaders) > 0 {
 		irRoute.RemoveResponseHeaders = httpFiltersContext.RemoveResponseHeaders
 	}
-	if httpFiltersContext.Mirror != nil {
-		irRoute.Mirror = httpFiltersContext.Mirror
+	if len(httpFiltersContext.Mirrors) > 0 {
+		irRoute.Mirrors = httpFiltersContext.Mirrors
 	}
 	if httpFiltersContext.RequestAuthentication != nil {
 		irRoute.RequestAuthentication = httpFiltersContext.RequestAuthentication
@@ -298,6 +358,7 @@ func applyHTTPFiltersContextToIRRoute(httpFiltersContext *HTTPFiltersContext, ir
 	if len(httpFiltersContext.ExtensionRefs) > 0 {
 		irRoute.ExtensionRefs = httpFiltersContext.ExtensionRefs
 	}
+	irRoute.DisableWebSocket = httpFiltersContext.DisableWebSocket
 
 }
 
@@ -358,8 +419,13 @@ func (t *Translator) processGRPCRouteRules(grpcRoute *GRPCRouteContext, parentRe
 		// a unique Xds IR HTTPRoute per match.
 		var ruleRoutes = t.processGRPCRouteRule(grpcRoute, ruleIdx, httpFiltersContext, rule)
 
+		var backendEndpoints [][]*ir.DestinationEndpoint
 		for _, backendRef := range rule.BackendRefs {
 			endpoints, backendWeight := t.processDestEndpoints(backendRef.BackendRef, parentRef, grpcRoute, resources)
+			if !t.processBackendRefFilters(grpcBackendRefExtensionRefs(backendRef.Filters), endpoints, parentRef, grpcRoute, resources) {
+				endpoints = nil
+			}
+			backendEndpoints = append(backendEndpoints, endpoints)
 			for _, route := range ruleRoutes {
 				// If the route already has a direct response or redirect configured, then it was from a filter so skip
 				// processing any destinations for this route.
@@ -380,6 +446,8 @@ func (t *Translator) processGRPCRouteRules(grpcRoute *GRPCRouteContext, parentRe
 			}
 		}
 
+		normalizeEndpointWeights(backendEndpoints)
+
 		// If the route has no valid backends then just use a direct response and don't fuss with weighted responses
 		for _, ruleRoute := range ruleRoutes {
 			if ruleRoute.BackendWeights.Invalid > 0 && ruleRoute.Destination == nil {
@@ -406,6 +474,7 @@ func (t *Translator) processGRPCRouteRule(grpcRoute *GRPCRouteContext, ruleIdx i
 	if len(rule.Matches) == 0 {
 		irRoute := &ir.HTTPRoute{
 			Name: irRouteName(grpcRoute, ruleIdx, -1),
+			GRPC: buildGRPCFeatures(httpFiltersContext),
 		}
 		applyHTTPFiltersContextToIRRoute(httpFiltersContext, irRoute)
 		ruleRoutes = append(ruleRoutes, irRoute)
@@ -417,6 +486,7 @@ func (t *Translator) processGRPCRouteRule(grpcRoute *GRPCRouteContext, ruleIdx i
 	for matchIdx, match := range rule.Matches {
 		irRoute := &ir.HTTPRoute{
 			Name: irRouteName(grpcRoute, ruleIdx, matchIdx),
+			GRPC: buildGRPCFeatures(httpFiltersContext),
 		}
 
 		for _, headerMatch := range match.Headers {
@@ -450,6 +520,14 @@ func (t *Translator) processGRPCRouteRule(grpcRoute *GRPCRouteContext, ruleIdx i
 	return ruleRoutes
 }
 
+// buildGRPCFeatures returns the gRPC features of the routes of a GRPCRoute rule.
+func buildGRPCFeatures(httpFiltersContext *HTTPFiltersContext) *ir.GRPCFeatures {
+	return &ir.GRPCFeatures{
+		DisableWeb:   httpFiltersContext.DisableGRPCWeb,
+		DisableStats: httpFiltersContext.DisableGRPCStats,
+	}
+}
+
 func (t *Translator) processGRPCRouteMethodExact(method *v1alpha2.GRPCMethodMatch, irRoute *ir.HTTPRoute) {
 	switch {
 	case method.Service != nil && method.Method != nil:
@@ -533,10 +611,12 @@ func (t *Translator) processHTTPRouteParentRefListener(route RouteContext, route
 					Redirect:              routeRoute.Redirect,
 					DirectResponse:        routeRoute.DirectResponse,
 					URLRewrite:            routeRoute.URLRewrite,
-					Mirror:                routeRoute.Mirror,
+					Mirrors:               routeRoute.Mirrors,
 					RequestAuthentication: routeRoute.RequestAuthentication,
 					RateLimit:             routeRoute.RateLimit,
 					ExtensionRefs:         routeRoute.ExtensionRefs,
+					DisableWebSocket:      routeRoute.DisableWebSocket,
+					GRPC:                  routeRoute.GRPC,
 				}
 				// Don't bother copying over the weights unless the route has invalid backends.
 				if routeRoute.BackendWeights.Invalid > 0 {
@@ -599,21 +679,20 @@ func (t *Translator) processTLSRouteParentRefs(tlsRoute *TLSRouteContext, resour
 		// Need to compute Route rules within the parentRef loop because
 		// any conditions that come out of it have to go on each RouteParentStatus,
 		// not on the Route as a whole.
-		var destEndpoints []*ir.DestinationEndpoint
+		var backendRefs []v1beta1.BackendRef
+		for _, rule := range tlsRoute.Spec.Rules {
+			backendRefs = append(backendRefs, rule.BackendRefs...)
+		}
 
 		// compute backends
-		for _, rule := range tlsRoute.Spec.Rules {
-			for _, backendRef := range rule.BackendRefs {
-				backendRef := backendRef
-				endpoints, _ := t.processDestEndpoints(backendRef, parentRef, tlsRoute, resources)
-				destEndpoints = append(destEndpoints, endpoints...)
+		destination, weightedDestinations := t.processL4Destinations(backendRefs, parentRef, tlsRoute, resources)
+		hasDestinations := destination != nil || len(weightedDestinations) > 0
+		if !hasDestinations {
+			// Keep an empty destination so that the connections matching the
+			// route are rejected rather than routed to another route.
+			destination = &ir.RouteDestination{
+				Name: irRouteDestinationName(tlsRoute, -1 /*rule index*/),
 			}
-
-			// TODO handle:
-			//	- no valid backend refs
-			//	- sum of weights for valid backend refs is 0
-			//	- returning 500's for invalid backend refs
-			//	- etc.
 		}
 
 		// If no negative condition has been set for ResolvedRefs, set "ResolvedRefs=True"
@@ -651,10 +730,8 @@ func (t *Translator) processTLSRouteParentRefs(tlsRoute *TLSRouteContext, resour
 				TLS: &ir.TLS{Passthrough: &ir.TLSInspectorConfig{
 					SNIs: hosts,
 				}},
-				Destination: &ir.RouteDestination{
-					Name:      irRouteDestinationName(tlsRoute, -1 /*rule index*/),
-					Endpoints: destEndpoints,
-				},
+				Destination:          destination,
+				WeightedDestinations: weightedDestinations,
 			}
 			gwXdsIR := xdsIR[irKey]
 			gwXdsIR.TCP = append(gwXdsIR.TCP, irListener)
@@ -662,7 +739,7 @@ func (t *Translator) processTLSRouteParentRefs(tlsRoute *TLSRouteContext, resour
 			// Theoretically there should only be one parent ref per
 			// Route that attaches to a given Listener, so fine to just increment here, but we
 			// might want to check to ensure we're not double-counting.
-			if len(irListener.Destination.Endpoints) > 0 {
+			if hasDestinations {
 				listener.IncrementAttachedRoutes()
 			}
 		}
@@ -723,8 +800,6 @@ func (t *Translator) processUDPRouteParentRefs(udpRoute *UDPRouteContext, resour
 		// Need to compute Route rules within the parentRef loop because
 		// any conditions that come out of it have to go on each RouteParentStatus,
 		// not on the Route as a whole.
-		var destEndpoints []*ir.DestinationEndpoint
-
 		// compute backends
 		if len(udpRoute.Spec.Rules) != 1 {
 			parentRef.SetCondition(udpRoute,
@@ -735,24 +810,13 @@ func (t *Translator) processUDPRouteParentRefs(udpRoute *UDPRouteContext, resour
 			)
 			continue
 		}
-		if len(udpRoute.Spec.Rules[0].BackendRefs) != 1 {
-			parentRef.SetCondition(udpRoute,
-				v1beta1.RouteConditionResolvedRefs,
-				metav1.ConditionFalse,
-				"InvalidBackend",
-				"One and only one backend is supported",
-			)
-			continue
-		}
 
-		backendRef := udpRoute.Spec.Rules[0].BackendRefs[0]
-		endpoints, _ := t.processDestEndpoints(backendRef, parentRef, udpRoute, resources)
+		destination, weightedDestinations := t.processL4Destinations(udpRoute.Spec.Rules[0].BackendRefs, parentRef, udpRoute, resources)
 		// Skip further processing if route destination is not valid
-		if len(endpoints) == 0 {
+		if destination == nil && len(weightedDestinations) == 0 {
 			continue
 		}
 
-		destEndpoints = append(destEndpoints, endpoints...)
 		// If no negative condition has been set for ResolvedRefs, set "ResolvedRefs=True"
 		if !parentRef.HasCondition(udpRoute, v1beta1.RouteConditionResolvedRefs, metav1.ConditionFalse) {
 			parentRef.SetCondition(udpRoute,
@@ -783,13 +847,11 @@ func (t *Translator) processUDPRouteParentRefs(udpRoute *UDPRouteContext, resour
 			// Create the UDP Listener while parsing the UDPRoute since
 			// the listener directly links to a routeDestination.
 			irListener := &ir.UDPListener{
-				Name:    irUDPListenerName(listener, udpRoute),
-				Address: "0.0.0.0",
-				Port:    uint32(containerPort),
-				Destination: &ir.RouteDestination{
-					Name:      irRouteDestinationName(udpRoute, -1 /*rule index*/),
-					Endpoints: destEndpoints,
-				},
+				Name:                 irUDPListenerName(listener, udpRoute),
+				Address:              "0.0.0.0",
+				Port:                 uint32(containerPort),
+				Destination:          destination,
+				WeightedDestinations: weightedDestinations,
 			}
 			gwXdsIR := xdsIR[irKey]
 			gwXdsIR.UDP = append(gwXdsIR.UDP, irListener)
@@ -797,9 +859,7 @@ func (t *Translator) processUDPRouteParentRefs(udpRoute *UDPRouteContext, resour
 			// Theoretically there should only be one parent ref per
 			// Route that attaches to a given Listener, so fine to just increment here, but we
 			// might want to check to ensure we're not double-counting.
-			if len(irListener.Destination.Endpoints) > 0 {
-				listener.IncrementAttachedRoutes()
-			}
+			listener.IncrementAttachedRoutes()
 		}
 
 		// If no negative conditions have been set, the route is considered "Accepted=True".
@@ -859,8 +919,6 @@ func (t *Translator) processTCPRouteParentRefs(tcpRoute *TCPRouteContext, resour
 		// Need to compute Route rules within the parentRef loop because
 		// any conditions that come out of it have to go on each RouteParentStatus,
 		// not on the Route as a whole.
-		var destEndpoints []*ir.DestinationEndpoint
-
 		// compute backends
 		if len(tcpRoute.Spec.Rules) != 1 {
 			parentRef.SetCondition(tcpRoute,
@@ -871,23 +929,13 @@ func (t *Translator) processTCPRouteParentRefs(tcpRoute *TCPRouteContext, resour
 			)
 			continue
 		}
-		if len(tcpRoute.Spec.Rules[0].BackendRefs) != 1 {
-			parentRef.SetCondition(tcpRoute,
-				v1beta1.RouteConditionResolvedRefs,
-				metav1.ConditionFalse,
-				"InvalidBackend",
-				"One and only one backend is supported",
-			)
-			continue
-		}
 
-		backendRef := tcpRoute.Spec.Rules[0].BackendRefs[0]
-		endpoints, _ := t.processDestEndpoints(backendRef, parentRef, tcpRoute, resources)
+		destination, weightedDestinations := t.processL4Destinations(tcpRoute.Spec.Rules[0].BackendRefs, parentRef, tcpRoute, resources)
 		// Skip further processing if route destination is not valid
-		if len(endpoints) == 0 {
+		if destination == nil && len(weightedDestinations) == 0 {
 			continue
 		}
-		destEndpoints = append(destEndpoints, endpoints...)
+
 		// If no negative condition has been set for ResolvedRefs, set "ResolvedRefs=True"
 		if !parentRef.HasCondition(tcpRoute, v1beta1.RouteConditionResolvedRefs, metav1.ConditionFalse) {
 			parentRef.SetCondition(tcpRoute,
@@ -918,14 +966,12 @@ func (t *Translator) processTCPRouteParentRefs(tcpRoute *TCPRouteContext, resour
 			// Create the TCP Listener while parsing the TCPRoute since
 			// the listener directly links to a routeDestination.
 			irListener := &ir.TCPListener{
-				Name:    irTCPListenerName(listener, tcpRoute),
-				Address: "0.0.0.0",
-				Port:    uint32(containerPort),
-				Destination: &ir.RouteDestination{
-					Name:      irRouteDestinationName(tcpRoute, -1 /*rule index*/),
-					Endpoints: destEndpoints,
-				},
-				TLS: &ir.TLS{Terminate: irTLSConfigs(listener.tlsSecrets)},
+				Name:                 irTCPListenerName(listener, tcpRoute),
+				Address:              "0.0.0.0",
+				Port:                 uint32(containerPort),
+				Destination:          destination,
+				WeightedDestinations: weightedDestinations,
+				TLS:                  &ir.TLS{Terminate: irTLSConfigs(listener.tlsSecrets)},
 			}
 			gwXdsIR := xdsIR[irKey]
 			gwXdsIR.TCP = append(gwXdsIR.TCP, irListener)
@@ -933,9 +979,7 @@ func (t *Translator) processTCPRouteParentRefs(tcpRoute *TCPRouteContext, resour
 			// Theoretically there should only be one parent ref per
 			// Route that attaches to a given Listener, so fine to just increment here, but we
 			// might want to check to ensure we're not double-counting.
-			if len(irListener.Destination.Endpoints) > 0 {
-				listener.IncrementAttachedRoutes()
-			}
+			listener.IncrementAttachedRoutes()
 		}
 
 		// If no negative conditions have been set, the route is considered "Accepted=True".
@@ -960,6 +1004,55 @@ func (t *Translator) processTCPRouteParentRefs(tcpRoute *TCPRouteContext, resour
 	}
 }
 
+// processL4Destinations translates the backendRefs of a TCPRoute, TLSRoute or UDPRoute into
+// the destinations of an L4 listener: a single destination if only one backend can receive traffic,
+// or a weighted destination per backend otherwise. Invalid backends and backends with a weight of 0
+// don't receive any traffic.
+func (t *Translator) processL4Destinations(backendRefs []v1beta1.BackendRef,
+	parentRef *RouteParentContext,
+	route RouteContext,
+	resources *Resources) (*ir.RouteDestination, []*ir.WeightedRouteDestination) {
+	destName := irRouteDestinationName(route, -1 /*rule index*/)
+
+	var weightedDestinations []*ir.WeightedRouteDestination
+	for i, backendRef := range backendRefs {
+		backendRef := backendRef
+		endpoints, weight := t.processDestEndpoints(backendRef, parentRef, route, resources)
+		if len(endpoints) == 0 || weight == 0 {
+			continue
+		}
+		weightedDestinations = append(weightedDestinations, &ir.WeightedRouteDestination{
+			Destination: &ir.RouteDestination{
+				Name:      fmt.Sprintf("%s/backend/%d", destName, i),
+				Endpoints: endpoints,
+			},
+			Weight: weight,
+		})
+	}
+
+	switch len(weightedDestinations) {
+	case 0:
+		return nil, nil
+	case 1:
+		destination := weightedDestinations[0].Destination
+		destination.Name = destName
+		return destination, nil
+	default:
+		// The endpoints are weighted like the ones of the HTTP routes, for the
+		// proxies merging the endpoints of the backends into a single cluster.
+		backendEndpoints := make([][]*ir.DestinationEndpoint, 0, len(weightedDestinations))
+		for _, weighted := range weightedDestinations {
+			for _, ep := range weighted.Destination.Endpoints {
+				epWeight := weighted.Weight
+				ep.Weight = &epWeight
+			}
+			backendEndpoints = append(backendEndpoints, weighted.Destination.Endpoints)
+		}
+		normalizeEndpointWeights(backendEndpoints)
+		return nil, weightedDestinations
+	}
+}
+
 // processDestEndpoints takes a backendRef and translates it into destination endpoints or sets error statuses and
 // returns the weight for the backend so that 500 error responses can be returned for invalid backends in
 // the same proportion as the backend would have otherwise received
@@ -980,32 +1073,197 @@ func (t *Translator) processDestEndpoints(backendRef v1beta1.BackendRef,
 		return nil, weight
 	}
 
-	var backendIps []string
-	switch KindDerefOr(backendRef.Kind, KindService) {
+	protocol := v1.ProtocolTCP
+	if routeType == KindUDPRoute {
+		protocol = v1.ProtocolUDP
+	}
+
+	var (
+		backendHosts []string
+		portName     string
+		appProtocol  *string
+	)
+	backendKind := KindDerefOr(backendRef.Kind, KindService)
+	switch backendKind {
 	case KindServiceImport:
-		backendIps = resources.GetServiceImport(backendNamespace, string(backendRef.Name)).Spec.IPs
+		serviceImport := resources.GetServiceImport(backendNamespace, string(backendRef.Name))
+		backendHosts = serviceImport.Spec.IPs
+		for _, port := range serviceImport.Spec.Ports {
+			if port.Port == int32(*backendRef.Port) && protocolDerefOrTCP(port.Protocol) == protocol {
+				portName = port.Name
+				appProtocol = port.AppProtocol
+				break
+			}
+		}
 	case KindService:
-		backendIps = []string{resources.GetService(backendNamespace, string(backendRef.Name)).Spec.ClusterIP}
+		service := resources.GetService(backendNamespace, string(backendRef.Name))
+		switch {
+		// ExternalName Services are resolved with DNS, they have no endpoints.
+		case service.Spec.Type == v1.ServiceTypeExternalName:
+			backendHosts = []string{service.Spec.ExternalName}
+		// Headless Services have no Cluster IP
+		case service.Spec.ClusterIP != "" && service.Spec.ClusterIP != v1.ClusterIPNone:
+			backendHosts = []string{service.Spec.ClusterIP}
+		}
+		for _, port := range service.Spec.Ports {
+			if port.Port == int32(*backendRef.Port) && protocolDerefOrTCP(port.Protocol) == protocol {
+				portName = port.Name
+				appProtocol = port.AppProtocol
+				break
+			}
+		}
+	}
+
+	// Route to the endpoints of the backend if its EndpointSlices are known, and
+	// to its cluster IPs otherwise or if the Service routing type is configured.
+	var endpointSlices []*discoveryv1.EndpointSlice
+	if backendKind != egv1a1.KindBackend {
+		endpointSlices = resources.GetEndpointSlicesForBackend(backendNamespace, string(backendRef.Name), backendKind)
+	}
+	switch {
+	case backendKind == egv1a1.KindBackend:
+		endpoints = getBackendEndpoints(resources.GetBackend(backendNamespace, string(backendRef.Name)))
+	case len(endpointSlices) > 0 && !endpointRoutingDisabled(resources.EnvoyProxy):
+		endpoints = getEndpointSliceEndpoints(endpointSlices, portName, protocol)
+	default:
+		for _, host := range backendHosts {
+			endpoints = append(endpoints, ir.NewDestEndpoint(host, uint32(*backendRef.Port)))
+		}
 	}
 
-	for _, ip := range backendIps {
-		var ep *ir.DestinationEndpoint
-		// Weights are not relevant for TCP and UDP Routes
-		if routeType == KindTCPRoute || routeType == KindUDPRoute {
-			ep = ir.NewDestEndpoint(
-				ip,
-				uint32(*backendRef.Port))
-		} else {
-			ep = ir.NewDestEndpointWithWeight(
-				ip,
-				uint32(*backendRef.Port),
-				weight)
-		}
-		endpoints = append(endpoints, ep)
+	// Weights are not relevant for TCP and UDP Routes
+	if routeType != KindTCPRoute && routeType != KindUDPRoute {
+		for _, ep := range endpoints {
+			epWeight := weight
+			ep.Weight = &epWeight
+			ep.Protocol = serviceAppProtocolToIRAppProtocol(appProtocol)
+		}
 	}
 	return endpoints, weight
 }
 
+// processBackendRefFilters applies the Envoy Gateway HTTPRouteFilters referenced by the
+// filters of a backendRef to the endpoints of the backend. It returns false if a filter
+// is invalid, the backend is then considered invalid.
+func (t *Translator) processBackendRefFilters(extRefs []*v1beta1.LocalObjectReference,
+	endpoints []*ir.DestinationEndpoint,
+	parentRef *RouteParentContext,
+	route RouteContext,
+	resources *Resources) bool {
+	var backend *egv1a1.HTTPBackendFilter
+	for _, extRef := range extRefs {
+		if string(extRef.Group) != egv1a1.GroupVersion.Group || string(extRef.Kind) != egv1a1.KindHTTPRouteFilter {
+			continue
+		}
+
+		// The filters must be in the same namespace as the route.
+		var routeFilter *egv1a1.HTTPRouteFilter
+		for _, hf := range resources.HTTPRouteFilters {
+			if hf.Namespace == route.GetNamespace() && hf.Name == string(extRef.Name) {
+				routeFilter = hf
+				break
+			}
+		}
+		if routeFilter == nil {
+			parentRef.SetCondition(route,
+				v1beta1.RouteConditionResolvedRefs,
+				metav1.ConditionFalse,
+				v1beta1.RouteReasonBackendNotFound,
+				fmt.Sprintf("HTTPRouteFilter %s/%s referenced by a backendRef not found", route.GetNamespace(), extRef.Name),
+			)
+			return false
+		}
+
+		spec := routeFilter.Spec
+		if spec.Backend == nil || spec.RequestRedirect != nil || spec.URLRewrite != nil ||
+			spec.RequestMirror != nil || spec.Protocols != nil {
+			parentRef.SetCondition(route,
+				v1beta1.RouteConditionAccepted,
+				metav1.ConditionFalse,
+				v1beta1.RouteReasonUnsupportedValue,
+				fmt.Sprintf("HTTPRouteFilter %s/%s referenced by a backendRef can only configure a backend",
+					routeFilter.Namespace, routeFilter.Name),
+			)
+			return false
+		}
+
+		// Can't have two backend extensions for the same backendRef
+		if backend != nil {
+			parentRef.SetCondition(route,
+				v1beta1.RouteConditionAccepted,
+				metav1.ConditionFalse,
+				v1beta1.RouteReasonUnsupportedValue,
+				"Cannot configure multiple HTTPRouteFilters with a backend for a single backendRef",
+			)
+			return false
+		}
+		backend = spec.Backend
+	}
+
+	if backend != nil && backend.Priority != nil && *backend.Priority > 0 {
+		for _, ep := range endpoints {
+			priority := uint32(*backend.Priority)
+			ep.Priority = &priority
+		}
+	}
+	return true
+}
+
+// httpBackendRefExtensionRefs returns the extension references of the filters of an
+// HTTPRoute backendRef.
+func httpBackendRefExtensionRefs(filters []v1beta1.HTTPRouteFilter) []*v1beta1.LocalObjectReference {
+	var extRefs []*v1beta1.LocalObjectReference
+	for _, filter := range filters {
+		if filter.Type == v1beta1.HTTPRouteFilterExtensionRef && filter.ExtensionRef != nil {
+			extRefs = append(extRefs, filter.ExtensionRef)
+		}
+	}
+	return extRefs
+}
+
+// grpcBackendRefExtensionRefs returns the extension references of the filters of a
+// GRPCRoute backendRef.
+func grpcBackendRefExtensionRefs(filters []v1alpha2.GRPCRouteFilter) []*v1beta1.LocalObjectReference {
+	var extRefs []*v1beta1.LocalObjectReference
+	for _, filter := range filters {
+		if filter.Type == v1alpha2.GRPCRouteFilterExtensionRef && filter.ExtensionRef != nil {
+			extRefs = append(extRefs, filter.ExtensionRef)
+		}
+	}
+	return extRefs
+}
+
+// serviceAppProtocolToIRAppProtocol translates the appProtocol of a Service port into
+// the application protocol of the backend. The backends are spoken to over HTTP/1.1
+// by default, which includes the WebSocket protocol (kubernetes.io/ws), so only the
+// HTTP2 application protocols are translated. Unknown application protocols are ignored.
+func serviceAppProtocolToIRAppProtocol(appProtocol *string) ir.AppProtocol {
+	if appProtocol == nil {
+		return ""
+	}
+	switch *appProtocol {
+	case "kubernetes.io/h2c", "http2", "grpc":
+		return ir.AppProtocolHTTP2
+	default:
+		return ""
+	}
+}
+
+// hasMixedAppProtocols returns true if some of the endpoints speak HTTP2 and others don't.
+func hasMixedAppProtocols(backendEndpoints [][]*ir.DestinationEndpoint) bool {
+	var http2, other bool
+	for _, endpoints := range backendEndpoints {
+		for _, ep := range endpoints {
+			if ep.Protocol == ir.AppProtocolHTTP2 {
+				http2 = true
+			} else {
+				other = true
+			}
+		}
+	}
+	return http2 && other
+}
+
 // processAllowedListenersForParentRefs finds out if the route attaches to one of our
 // Gateways' listeners, and if so, gets the list of listeners that allow it to
 // attach for each parentRef.
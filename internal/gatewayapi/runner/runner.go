@@ -147,6 +147,26 @@ func (r *Runner) subscribeAndTranslate(ctx context.Context) {
 				key := utils.NamespacedName(udpRoute)
 				r.ProviderResources.UDPRouteStatuses.Store(key, &udpRoute.Status)
 			}
+			for _, localReplyPolicy := range result.LocalReplyPolicies {
+				localReplyPolicy := localReplyPolicy
+				key := utils.NamespacedName(localReplyPolicy)
+				r.ProviderResources.LocalReplyPolicyStatuses.Store(key, &localReplyPolicy.Status)
+			}
+			for _, l4TrafficPolicy := range result.L4TrafficPolicies {
+				l4TrafficPolicy := l4TrafficPolicy
+				key := utils.NamespacedName(l4TrafficPolicy)
+				r.ProviderResources.L4TrafficPolicyStatuses.Store(key, &l4TrafficPolicy.Status)
+			}
+			for _, grpcJSONTranscoderPolicy := range result.GRPCJSONTranscoderPolicies {
+				grpcJSONTranscoderPolicy := grpcJSONTranscoderPolicy
+				key := utils.NamespacedName(grpcJSONTranscoderPolicy)
+				r.ProviderResources.GRPCJSONTranscoderPolicyStatuses.Store(key, &grpcJSONTranscoderPolicy.Status)
+			}
+			for _, accessLogPolicy := range result.AccessLogPolicies {
+				accessLogPolicy := accessLogPolicy
+				key := utils.NamespacedName(accessLogPolicy)
+				r.ProviderResources.AccessLogPolicyStatuses.Store(key, &accessLogPolicy.Status)
+			}
 		},
 	)
 	r.Logger.Info("shutting down")
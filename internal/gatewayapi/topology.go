@@ -0,0 +1,59 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package gatewayapi
+
+import (
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+var _ TopologyTranslator = (*Translator)(nil)
+
+type TopologyTranslator interface {
+	ProcessProxyTopology(gateways []*GatewayContext, xdsIR XdsIRMap, resources *Resources)
+}
+
+// ProcessProxyTopology sets the endpoints of the Envoy proxies of each Gateway,
+// from the EndpointSlices of the proxy Service. Envoy compares the zones of the
+// proxies and of the backends to route the requests within its own zone.
+func (t *Translator) ProcessProxyTopology(gateways []*GatewayContext, xdsIR XdsIRMap, resources *Resources) {
+	for _, gateway := range gateways {
+		gwXdsIR, ok := xdsIR[irStringKey(gateway.Namespace, gateway.Name)]
+		if !ok {
+			continue
+		}
+
+		var topology *ir.ProxyTopology
+		for _, endpointSlice := range resources.EndpointSlices {
+			if endpointSlice.Labels[OwningGatewayNamespaceLabel] != gateway.Namespace ||
+				endpointSlice.Labels[OwningGatewayNameLabel] != gateway.Name {
+				continue
+			}
+			if topology == nil {
+				topology = &ir.ProxyTopology{}
+			}
+
+			// Envoy never connects to the proxies of its local cluster,
+			// any port of the proxy Service identifies them.
+			if len(endpointSlice.Ports) == 0 || endpointSlice.Ports[0].Port == nil {
+				continue
+			}
+			port := uint32(*endpointSlice.Ports[0].Port)
+
+			for _, endpoint := range endpointSlice.Endpoints {
+				if endpoint.Conditions.Ready != nil && !*endpoint.Conditions.Ready {
+					continue
+				}
+				if len(endpoint.Addresses) == 0 {
+					continue
+				}
+				ep := ir.NewDestEndpoint(endpoint.Addresses[0], port)
+				ep.Zone = endpoint.Zone
+				topology.Endpoints = append(topology.Endpoints, ep)
+			}
+		}
+		gwXdsIR.ProxyTopology = topology
+	}
+}
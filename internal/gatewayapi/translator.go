@@ -8,6 +8,8 @@ package gatewayapi
 import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
 )
 
 const (
@@ -23,6 +25,7 @@ const (
 	KindService       = "Service"
 	KindServiceImport = "ServiceImport"
 	KindSecret        = "Secret"
+	KindConfigMap     = "ConfigMap"
 
 	GroupMultiClusterService = "multicluster.x-k8s.io"
 	// OwningGatewayNamespaceLabel is the owner reference label used for managed infra.
@@ -33,6 +36,10 @@ const (
 	// The value should be the name of the accepted Envoy Gateway.
 	OwningGatewayNameLabel = "gateway.envoyproxy.io/owning-gateway-name"
 
+	// RouteReasonUnsupportedProtocol is used with the ResolvedRefs condition when the
+	// backends of a rule declare application protocols that can't be served together.
+	RouteReasonUnsupportedProtocol v1beta1.RouteConditionReason = "UnsupportedProtocol"
+
 	// minEphemeralPort is the first port in the ephemeral port range.
 	minEphemeralPort = 1024
 	// wellKnownPortShift is the constant added to the well known port (1-1023)
@@ -49,6 +56,7 @@ type TranslatorManager interface {
 	RoutesTranslator
 	ListenersTranslator
 	AddressesTranslator
+	TopologyTranslator
 	FiltersTranslator
 }
 
@@ -84,6 +92,10 @@ func newTranslateResult(gateways []*GatewayContext,
 	tlsRoutes []*TLSRouteContext,
 	tcpRoutes []*TCPRouteContext,
 	udpRoutes []*UDPRouteContext,
+	localReplyPolicies []*egv1a1.LocalReplyPolicy,
+	l4TrafficPolicies []*egv1a1.L4TrafficPolicy,
+	grpcJSONTranscoderPolicies []*egv1a1.GRPCJSONTranscoderPolicy,
+	accessLogPolicies []*egv1a1.AccessLogPolicy,
 	xdsIR XdsIRMap, infraIR InfraIRMap) *TranslateResult {
 	translateResult := &TranslateResult{
 		XdsIR:   xdsIR,
@@ -108,6 +120,10 @@ func newTranslateResult(gateways []*GatewayContext,
 	for _, udpRoute := range udpRoutes {
 		translateResult.UDPRoutes = append(translateResult.UDPRoutes, udpRoute.UDPRoute)
 	}
+	translateResult.LocalReplyPolicies = append(translateResult.LocalReplyPolicies, localReplyPolicies...)
+	translateResult.L4TrafficPolicies = append(translateResult.L4TrafficPolicies, l4TrafficPolicies...)
+	translateResult.GRPCJSONTranscoderPolicies = append(translateResult.GRPCJSONTranscoderPolicies, grpcJSONTranscoderPolicies...)
+	translateResult.AccessLogPolicies = append(translateResult.AccessLogPolicies, accessLogPolicies...)
 
 	return translateResult
 }
@@ -125,9 +141,15 @@ func (t *Translator) Translate(resources *Resources) *TranslateResult {
 	// Process EnvoyPatchPolicies
 	t.ProcessEnvoyPatchPolicies(resources.EnvoyPatchPolicies, xdsIR)
 
+	// Process LocalReplyPolicies
+	localReplyPolicies := t.ProcessLocalReplyPolicies(resources.LocalReplyPolicies, gateways, resources, xdsIR)
+
 	// Process all Addresses for all relevant Gateways.
 	t.ProcessAddresses(gateways, xdsIR, infraIR, resources)
 
+	// Process the topology of the Envoy proxies of all relevant Gateways.
+	t.ProcessProxyTopology(gateways, xdsIR, resources)
+
 	// Process all relevant HTTPRoutes.
 	httpRoutes := t.ProcessHTTPRoutes(resources.HTTPRoutes, gateways, resources, xdsIR)
 
@@ -143,10 +165,20 @@ func (t *Translator) Translate(resources *Resources) *TranslateResult {
 	// Process all relevant UDPRoutes.
 	udpRoutes := t.ProcessUDPRoutes(resources.UDPRoutes, gateways, resources, xdsIR)
 
+	// Process L4TrafficPolicies
+	l4TrafficPolicies := t.ProcessL4TrafficPolicies(resources.L4TrafficPolicies, gateways, tcpRoutes, tlsRoutes, udpRoutes, xdsIR)
+
+	// Process GRPCJSONTranscoderPolicies
+	grpcJSONTranscoderPolicies := t.ProcessGRPCJSONTranscoderPolicies(resources.GRPCJSONTranscoderPolicies, grpcRoutes, resources, xdsIR)
+
+	// Process AccessLogPolicies
+	accessLogPolicies := t.ProcessAccessLogPolicies(resources.AccessLogPolicies, gateways, httpRoutes, grpcRoutes, resources, xdsIR)
+
 	// Sort xdsIR based on the Gateway API spec
 	sortXdsIRMap(xdsIR)
 
-	return newTranslateResult(gateways, httpRoutes, grpcRoutes, tlsRoutes, tcpRoutes, udpRoutes, xdsIR, infraIR)
+	return newTranslateResult(gateways, httpRoutes, grpcRoutes, tlsRoutes, tcpRoutes, udpRoutes, localReplyPolicies, l4TrafficPolicies,
+		grpcJSONTranscoderPolicies, accessLogPolicies, xdsIR, infraIR)
 }
 
 // GetRelevantGateways returns GatewayContexts, containing a copy of the original
@@ -6,6 +6,7 @@
 package gatewayapi
 
 import (
+	"errors"
 	"fmt"
 	"net/netip"
 	"strings"
@@ -16,6 +17,8 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation"
 	"sigs.k8s.io/gateway-api/apis/v1alpha2"
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
 )
 
 func (t *Translator) validateBackendRef(backendRef *v1alpha2.BackendRef, parentRef *RouteParentContext, route RouteContext,
@@ -26,17 +29,21 @@ func (t *Translator) validateBackendRef(backendRef *v1alpha2.BackendRef, parentR
 	if !t.validateBackendRefKind(backendRef, parentRef, route) {
 		return false
 	}
+	if !t.validateBackendRefGroupKind(backendRef, parentRef, route) {
+		return false
+	}
 	if !t.validateBackendNamespace(backendRef, parentRef, route, resources, routeKind) {
 		return false
 	}
-	if !t.validateBackendPort(backendRef, parentRef, route) {
+	backendRefKind := KindDerefOr(backendRef.Kind, KindService)
+	// The ports of a Backend are defined by its endpoints.
+	if backendRefKind != egv1a1.KindBackend && !t.validateBackendPort(backendRef, parentRef, route) {
 		return false
 	}
 	protocol := v1.ProtocolTCP
 	if routeKind == KindUDPRoute {
 		protocol = v1.ProtocolUDP
 	}
-	backendRefKind := KindDerefOr(backendRef.Kind, KindService)
 	switch backendRefKind {
 	case KindService:
 		if !t.validateBackendService(backendRef, parentRef, resources, backendNamespace, route, protocol) {
@@ -46,17 +53,23 @@ func (t *Translator) validateBackendRef(backendRef *v1alpha2.BackendRef, parentR
 		if !t.validateBackendServiceImport(backendRef, parentRef, resources, backendNamespace, route, protocol) {
 			return false
 		}
+	case egv1a1.KindBackend:
+		if !t.validateBackend(backendRef, parentRef, resources, backendNamespace, route) {
+			return false
+		}
 	}
 	return true
 }
 
 func (t *Translator) validateBackendRefGroup(backendRef *v1alpha2.BackendRef, parentRef *RouteParentContext, route RouteContext) bool {
-	if backendRef.Group != nil && *backendRef.Group != "" && *backendRef.Group != GroupMultiClusterService {
+	if backendRef.Group != nil && *backendRef.Group != "" && *backendRef.Group != GroupMultiClusterService &&
+		string(*backendRef.Group) != egv1a1.GroupVersion.Group {
 		parentRef.SetCondition(route,
 			v1beta1.RouteConditionResolvedRefs,
 			metav1.ConditionFalse,
 			v1beta1.RouteReasonInvalidKind,
-			fmt.Sprintf("Group is invalid, only the core API group (specified by omitting the group field or setting it to an empty string) and %s is supported", GroupMultiClusterService),
+			fmt.Sprintf("Group is invalid, only the core API group (specified by omitting the group field or setting it to an empty string), %s and %s are supported",
+				GroupMultiClusterService, egv1a1.GroupVersion.Group),
 		)
 		return false
 	}
@@ -64,12 +77,40 @@ func (t *Translator) validateBackendRefGroup(backendRef *v1alpha2.BackendRef, pa
 }
 
 func (t *Translator) validateBackendRefKind(backendRef *v1alpha2.BackendRef, parentRef *RouteParentContext, route RouteContext) bool {
-	if backendRef.Kind != nil && *backendRef.Kind != KindService && *backendRef.Kind != KindServiceImport {
+	if backendRef.Kind != nil && *backendRef.Kind != KindService && *backendRef.Kind != KindServiceImport &&
+		*backendRef.Kind != egv1a1.KindBackend {
+		parentRef.SetCondition(route,
+			v1beta1.RouteConditionResolvedRefs,
+			metav1.ConditionFalse,
+			v1beta1.RouteReasonInvalidKind,
+			"Kind is invalid, only Service, MCS ServiceImport and Backend are supported",
+		)
+		return false
+	}
+	return true
+}
+
+// backendRefKindGroups are the API groups of the supported kinds of backendRefs.
+var backendRefKindGroups = map[string]string{
+	KindService:        "",
+	KindServiceImport:  GroupMultiClusterService,
+	egv1a1.KindBackend: egv1a1.GroupVersion.Group,
+}
+
+// validateBackendRefGroupKind validates that the group of the backendRef is the
+// API group of its kind, since the group and the kind are validated separately.
+func (t *Translator) validateBackendRefGroupKind(backendRef *v1alpha2.BackendRef, parentRef *RouteParentContext, route RouteContext) bool {
+	kind := KindDerefOr(backendRef.Kind, KindService)
+	group := GroupDerefOr(backendRef.Group, "")
+	if expectedGroup := backendRefKindGroups[kind]; group != expectedGroup {
+		if expectedGroup == "" {
+			expectedGroup = "the core API group"
+		}
 		parentRef.SetCondition(route,
 			v1beta1.RouteConditionResolvedRefs,
 			metav1.ConditionFalse,
 			v1beta1.RouteReasonInvalidKind,
-			"Kind is invalid, only Service and MCS ServiceImport is supported",
+			fmt.Sprintf("Group is invalid for Kind %s, only %s is supported", kind, expectedGroup),
 		)
 		return false
 	}
@@ -130,6 +171,11 @@ func (t *Translator) validateBackendService(backendRef *v1alpha2.BackendRef, par
 		)
 		return false
 	}
+	// The ports of an ExternalName Service are optional, the requests are
+	// forwarded to the port of the backendRef.
+	if service.Spec.Type == v1.ServiceTypeExternalName {
+		return true
+	}
 	var portFound bool
 	for _, port := range service.Spec.Ports {
 		portProtocol := port.Protocol
@@ -193,6 +239,57 @@ func (t *Translator) validateBackendServiceImport(backendRef *v1alpha2.BackendRe
 	return true
 }
 
+func (t *Translator) validateBackend(backendRef *v1alpha2.BackendRef, parentRef *RouteParentContext, resources *Resources,
+	backendNamespace string, route RouteContext) bool {
+	backend := resources.GetBackend(backendNamespace, string(backendRef.Name))
+	if backend == nil {
+		parentRef.SetCondition(route,
+			v1beta1.RouteConditionResolvedRefs,
+			metav1.ConditionFalse,
+			v1beta1.RouteReasonBackendNotFound,
+			fmt.Sprintf("Backend %s/%s not found", backendNamespace, string(backendRef.Name)),
+		)
+		return false
+	}
+
+	if err := validateBackendEndpoints(backend.Spec.Endpoints); err != nil {
+		parentRef.SetCondition(route,
+			v1beta1.RouteConditionResolvedRefs,
+			metav1.ConditionFalse,
+			"InvalidBackend",
+			fmt.Sprintf("Backend %s/%s is invalid: %v", backendNamespace, string(backendRef.Name), err),
+		)
+		return false
+	}
+	return true
+}
+
+// validateBackendEndpoints ensures each endpoint of a Backend is either a valid
+// hostname or a valid IP address.
+func validateBackendEndpoints(endpoints []egv1a1.BackendEndpoint) error {
+	if len(endpoints) == 0 {
+		return errors.New("at least one endpoint must be specified")
+	}
+	for i, endpoint := range endpoints {
+		switch {
+		case (endpoint.FQDN == nil) == (endpoint.IP == nil):
+			return fmt.Errorf("endpoint %d must specify exactly one of fqdn and ip", i)
+		case endpoint.FQDN != nil:
+			if errs := validation.IsDNS1123Subdomain(endpoint.FQDN.Hostname); len(errs) > 0 {
+				return fmt.Errorf("hostname %s of endpoint %d is invalid: %s", endpoint.FQDN.Hostname, i, strings.Join(errs, "; "))
+			}
+			if _, err := netip.ParseAddr(endpoint.FQDN.Hostname); err == nil {
+				return fmt.Errorf("hostname %s of endpoint %d is an IP address", endpoint.FQDN.Hostname, i)
+			}
+		case endpoint.IP != nil:
+			if _, err := netip.ParseAddr(endpoint.IP.Address); err != nil {
+				return fmt.Errorf("address %s of endpoint %d is not a valid IP address", endpoint.IP.Address, i)
+			}
+		}
+	}
+	return nil
+}
+
 func (t *Translator) validateListenerConditions(listener *ListenerContext) (isReady bool) {
 	lConditions := listener.GetConditions()
 	if len(lConditions) == 0 {
@@ -139,6 +139,17 @@ func (in *Resources) DeepCopyInto(out *Resources) {
 			}
 		}
 	}
+	if in.Backends != nil {
+		in, out := &in.Backends, &out.Backends
+		*out = make([]*apiv1alpha1.Backend, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(apiv1alpha1.Backend)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.EndpointSlices != nil {
 		in, out := &in.EndpointSlices, &out.EndpointSlices
 		*out = make([]*discoveryv1.EndpointSlice, len(*in))
@@ -161,6 +172,17 @@ func (in *Resources) DeepCopyInto(out *Resources) {
 			}
 		}
 	}
+	if in.ConfigMaps != nil {
+		in, out := &in.ConfigMaps, &out.ConfigMaps
+		*out = make([]*v1.ConfigMap, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(v1.ConfigMap)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.AuthenticationFilters != nil {
 		in, out := &in.AuthenticationFilters, &out.AuthenticationFilters
 		*out = make([]*apiv1alpha1.AuthenticationFilter, len(*in))
@@ -183,6 +205,17 @@ func (in *Resources) DeepCopyInto(out *Resources) {
 			}
 		}
 	}
+	if in.HTTPRouteFilters != nil {
+		in, out := &in.HTTPRouteFilters, &out.HTTPRouteFilters
+		*out = make([]*apiv1alpha1.HTTPRouteFilter, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(apiv1alpha1.HTTPRouteFilter)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.EnvoyProxy != nil {
 		in, out := &in.EnvoyProxy, &out.EnvoyProxy
 		*out = new(configv1alpha1.EnvoyProxy)
@@ -206,6 +239,50 @@ func (in *Resources) DeepCopyInto(out *Resources) {
 			}
 		}
 	}
+	if in.LocalReplyPolicies != nil {
+		in, out := &in.LocalReplyPolicies, &out.LocalReplyPolicies
+		*out = make([]*apiv1alpha1.LocalReplyPolicy, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(apiv1alpha1.LocalReplyPolicy)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.L4TrafficPolicies != nil {
+		in, out := &in.L4TrafficPolicies, &out.L4TrafficPolicies
+		*out = make([]*apiv1alpha1.L4TrafficPolicy, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(apiv1alpha1.L4TrafficPolicy)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.GRPCJSONTranscoderPolicies != nil {
+		in, out := &in.GRPCJSONTranscoderPolicies, &out.GRPCJSONTranscoderPolicies
+		*out = make([]*apiv1alpha1.GRPCJSONTranscoderPolicy, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(apiv1alpha1.GRPCJSONTranscoderPolicy)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.AccessLogPolicies != nil {
+		in, out := &in.AccessLogPolicies, &out.AccessLogPolicies
+		*out = make([]*apiv1alpha1.AccessLogPolicy, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(apiv1alpha1.AccessLogPolicy)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Resources.
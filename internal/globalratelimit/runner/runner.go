@@ -148,6 +148,16 @@ func (r *Runner) translate(xdsIR *ir.Xds) (*types.ResourceVersionTable, error) {
 			}
 		}
 	}
+
+	for _, listener := range xdsIR.TCP {
+		cfg := translator.BuildTCPRateLimitServiceConfig(listener)
+		if cfg != nil {
+			// Add to xDS Config resources.
+			if err := resourceVT.AddXdsResource(resourcev3.RateLimitConfigType, cfg); err != nil {
+				return nil, err
+			}
+		}
+	}
 	return resourceVT, nil
 }
 
@@ -38,6 +38,8 @@ const (
 	envoyNsEnvVar = "ENVOY_GATEWAY_NAMESPACE"
 	// envoyPodEnvVar is the name of the Envoy pod name environment variable.
 	envoyPodEnvVar = "ENVOY_POD_NAME"
+	// envoyZoneEnvVar is the name of the Envoy pod zone environment variable.
+	envoyZoneEnvVar = "ENVOY_SERVICE_ZONE"
 )
 
 var (
@@ -85,7 +87,7 @@ func envoyLabels(extraLabels map[string]string) map[string]string {
 }
 
 // expectedProxyContainers returns expected proxy containers.
-func expectedProxyContainers(infra *ir.ProxyInfra, deploymentConfig *egcfgv1a1.KubernetesDeploymentSpec) ([]corev1.Container, error) {
+func expectedProxyContainers(infra *ir.ProxyInfra, deploymentConfig *egcfgv1a1.KubernetesDeploymentSpec, zone *string) ([]corev1.Container, error) {
 	// Define slice to hold container ports
 	var ports []corev1.ContainerPort
 
@@ -110,9 +112,13 @@ func expectedProxyContainers(infra *ir.ProxyInfra, deploymentConfig *egcfgv1a1.K
 		}
 	}
 
-	var proxyMetrics *egcfgv1a1.ProxyMetrics
+	var (
+		proxyMetrics  *egcfgv1a1.ProxyMetrics
+		proxyOverload *egcfgv1a1.ProxyOverload
+	)
 	if infra.Config != nil {
 		proxyMetrics = infra.Config.Spec.Telemetry.Metrics
+		proxyOverload = infra.Config.Spec.Overload
 	}
 
 	if proxyMetrics != nil && proxyMetrics.Prometheus != nil {
@@ -126,7 +132,7 @@ func expectedProxyContainers(infra *ir.ProxyInfra, deploymentConfig *egcfgv1a1.K
 	var bootstrapConfigurations string
 
 	// Get the default Bootstrap
-	bootstrapConfigurations, err := bootstrap.GetRenderedBootstrapConfig(proxyMetrics)
+	bootstrapConfigurations, err := bootstrap.GetRenderedBootstrapConfig(proxyMetrics, proxyOverload)
 	if err != nil {
 		return nil, err
 	}
@@ -145,6 +151,8 @@ func expectedProxyContainers(infra *ir.ProxyInfra, deploymentConfig *egcfgv1a1.K
 	args := []string{
 		fmt.Sprintf("--service-cluster %s", infra.Name),
 		fmt.Sprintf("--service-node $(%s)", envoyPodEnvVar),
+		// The zone is a separate argument since it is empty if the pod has no zone label.
+		"--service-zone", fmt.Sprintf("$(%s)", envoyZoneEnvVar),
 		fmt.Sprintf("--config-yaml %s", bootstrapConfigurations),
 		fmt.Sprintf("--log-level %s", logging.DefaultEnvoyProxyLoggingLevel()),
 		"--cpuset-threads",
@@ -166,7 +174,7 @@ func expectedProxyContainers(infra *ir.ProxyInfra, deploymentConfig *egcfgv1a1.K
 			ImagePullPolicy:          corev1.PullIfNotPresent,
 			Command:                  []string{"envoy"},
 			Args:                     args,
-			Env:                      expectedProxyContainerEnv(deploymentConfig),
+			Env:                      expectedProxyContainerEnv(deploymentConfig, zone),
 			Resources:                *deploymentConfig.Container.Resources,
 			SecurityContext:          deploymentConfig.Container.SecurityContext,
 			Ports:                    ports,
@@ -249,7 +257,7 @@ func expectedDeploymentVolumes(name string, deploymentSpec *egcfgv1a1.Kubernetes
 }
 
 // expectedProxyContainerEnv returns expected proxy container envs.
-func expectedProxyContainerEnv(deploymentConfig *egcfgv1a1.KubernetesDeploymentSpec) []corev1.EnvVar {
+func expectedProxyContainerEnv(deploymentConfig *egcfgv1a1.KubernetesDeploymentSpec, zone *string) []corev1.EnvVar {
 	env := []corev1.EnvVar{
 		{
 			Name: envoyNsEnvVar,
@@ -271,5 +279,25 @@ func expectedProxyContainerEnv(deploymentConfig *egcfgv1a1.KubernetesDeploymentS
 		},
 	}
 
+	// The zone of the pod is the locality of Envoy, compared with the zones of
+	// the endpoints for zone aware routing. Kubernetes only sets the zone label
+	// on the nodes, the label of the pod is used if the zone isn't configured.
+	if zone != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envoyZoneEnvVar,
+			Value: *zone,
+		})
+	} else {
+		env = append(env, corev1.EnvVar{
+			Name: envoyZoneEnvVar,
+			ValueFrom: &corev1.EnvVarSource{
+				FieldRef: &corev1.ObjectFieldSelector{
+					APIVersion: "v1",
+					FieldPath:  fmt.Sprintf("metadata.labels['%s']", corev1.LabelTopologyZone),
+				},
+			},
+		})
+	}
+
 	return resource.ExpectedProxyContainerEnv(deploymentConfig.Container, env)
 }
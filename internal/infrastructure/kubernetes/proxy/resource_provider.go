@@ -151,7 +151,8 @@ func (r *ResourceRender) Deployment() (*appsv1.Deployment, error) {
 	if provider.Type != egcfgv1a1.ProviderTypeKubernetes {
 		return nil, fmt.Errorf("invalid provider type %v for Kubernetes infra manager", provider.Type)
 	}
-	deploymentConfig := provider.GetEnvoyProxyKubeProvider().EnvoyDeployment
+	kubeProvider := provider.GetEnvoyProxyKubeProvider()
+	deploymentConfig := kubeProvider.EnvoyDeployment
 
 	enablePrometheus := false
 	if r.infra.Config != nil &&
@@ -161,7 +162,7 @@ func (r *ResourceRender) Deployment() (*appsv1.Deployment, error) {
 	}
 
 	// Get expected bootstrap configurations rendered ProxyContainers
-	containers, err := expectedProxyContainers(r.infra, deploymentConfig)
+	containers, err := expectedProxyContainers(r.infra, deploymentConfig, kubeProvider.Zone)
 	if err != nil {
 		return nil, err
 	}
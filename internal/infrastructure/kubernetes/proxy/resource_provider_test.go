@@ -69,6 +69,7 @@ func TestDeployment(t *testing.T) {
 		bootstrap    string
 		telemetry    *egcfgv1a1.ProxyTelemetry
 		concurrency  *int32
+		zone         *string
 	}{
 		{
 			caseName: "default",
@@ -270,6 +271,12 @@ func TestDeployment(t *testing.T) {
 			concurrency: pointer.Int32(4),
 			bootstrap:   `test bootstrap config`,
 		},
+		{
+			caseName: "with-zone",
+			infra:    newTestInfra(),
+			deploy:   nil,
+			zone:     pointer.String("us-east-1a"),
+		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.caseName, func(t *testing.T) {
@@ -277,6 +284,7 @@ func TestDeployment(t *testing.T) {
 			if tc.deploy != nil {
 				kube.EnvoyDeployment = tc.deploy
 			}
+			kube.Zone = tc.zone
 			replace := egcfgv1a1.BootstrapTypeReplace
 			if tc.bootstrap != "" {
 				tc.infra.Proxy.Config.Spec.Bootstrap = &egcfgv1a1.ProxyBootstrap{
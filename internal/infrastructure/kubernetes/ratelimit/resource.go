@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"net"
 	"strconv"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -22,10 +23,24 @@ import (
 )
 
 const (
+	// BackendTypeEnvVar is the type of the database backend.
+	BackendTypeEnvVar = "BACKEND_TYPE"
+	// MemcacheHostPortEnvVar is the memcache host:port addresses.
+	MemcacheHostPortEnvVar = "MEMCACHE_HOST_PORT"
 	// RedisSocketTypeEnvVar is the redis socket type.
 	RedisSocketTypeEnvVar = "REDIS_SOCKET_TYPE"
+	// RedisTypeEnvVar is the redis type.
+	RedisTypeEnvVar = "REDIS_TYPE"
 	// RedisURLEnvVar is the redis url.
 	RedisURLEnvVar = "REDIS_URL"
+	// RedisPipelineWindowEnvVar is the redis pipeline window.
+	RedisPipelineWindowEnvVar = "REDIS_PIPELINE_WINDOW"
+	// RedisAuthEnvVar is the redis auth.
+	RedisAuthEnvVar = "REDIS_AUTH"
+	// RedisPasswordEnvVar is the redis password, referenced by the redis auth.
+	RedisPasswordEnvVar = "REDIS_PASSWORD"
+	// RedisPasswordSecretKey is the key of the redis password in its secret.
+	RedisPasswordSecretKey = "password"
 	// RedisTLSEnvVar is the redis tls.
 	RedisTLSEnvVar = "REDIS_TLS"
 	// RedisTLSClientCertEnvVar is the redis tls client cert.
@@ -91,6 +106,9 @@ const (
 	ReadinessPath = "/healthcheck"
 	// ReadinessPort is readiness port for readiness probe.
 	ReadinessPort = 8080
+	// redisClusterPipelineWindow is the implicit pipelining window required by
+	// the redis cluster mode of the rate limit service.
+	redisClusterPipelineWindow = "150us"
 )
 
 // GetServiceURL returns the URL for the rate limit service.
@@ -162,7 +180,7 @@ func expectedContainerVolumeMounts(rateLimit *egcfgv1a1.RateLimit, rateLimitDepl
 		ReadOnly:  true,
 	})
 
-	if rateLimit.Backend.Redis.TLS != nil {
+	if redis := rateLimit.Backend.Redis; redis != nil && redis.TLS != nil {
 		volumeMounts = append(volumeMounts, corev1.VolumeMount{
 			Name:      "redis-certs",
 			MountPath: "/redis-certs",
@@ -177,12 +195,12 @@ func expectedContainerVolumeMounts(rateLimit *egcfgv1a1.RateLimit, rateLimitDepl
 func expectedDeploymentVolumes(rateLimit *egcfgv1a1.RateLimit, rateLimitDeployment *egcfgv1a1.KubernetesDeploymentSpec) []corev1.Volume {
 	var volumes []corev1.Volume
 
-	if rateLimit.Backend.Redis.TLS != nil && rateLimit.Backend.Redis.TLS.CertificateRef != nil {
+	if redis := rateLimit.Backend.Redis; redis != nil && redis.TLS != nil && redis.TLS.CertificateRef != nil {
 		volumes = append(volumes, corev1.Volume{
 			Name: "redis-certs",
 			VolumeSource: corev1.VolumeSource{
 				Secret: &corev1.SecretVolumeSource{
-					SecretName:  string(rateLimit.Backend.Redis.TLS.CertificateRef.Name),
+					SecretName:  string(redis.TLS.CertificateRef.Name),
 					DefaultMode: pointer.Int32(420),
 				},
 			},
@@ -204,15 +222,8 @@ func expectedDeploymentVolumes(rateLimit *egcfgv1a1.RateLimit, rateLimitDeployme
 
 // expectedRateLimitContainerEnv returns expected rateLimit container envs.
 func expectedRateLimitContainerEnv(rateLimit *egcfgv1a1.RateLimit, rateLimitDeployment *egcfgv1a1.KubernetesDeploymentSpec) []corev1.EnvVar {
-	env := []corev1.EnvVar{
-		{
-			Name:  RedisSocketTypeEnvVar,
-			Value: "tcp",
-		},
-		{
-			Name:  RedisURLEnvVar,
-			Value: rateLimit.Backend.Redis.URL,
-		},
+	env := expectedRateLimitBackendEnv(&rateLimit.Backend)
+	env = append(env, []corev1.EnvVar{
 		{
 			Name:  RuntimeRootEnvVar,
 			Value: "/data",
@@ -285,15 +296,15 @@ func expectedRateLimitContainerEnv(rateLimit *egcfgv1a1.RateLimit, rateLimitDepl
 			Name:  ForceStartWithoutInitialConfigEnvVar,
 			Value: "true",
 		},
-	}
+	}...)
 
-	if rateLimit.Backend.Redis.TLS != nil {
+	if redis := rateLimit.Backend.Redis; redis != nil && redis.TLS != nil {
 		env = append(env, corev1.EnvVar{
 			Name:  RedisTLSEnvVar,
 			Value: "true",
 		})
 
-		if rateLimit.Backend.Redis.TLS.CertificateRef != nil {
+		if redis.TLS.CertificateRef != nil {
 			env = append(env, []corev1.EnvVar{
 				{
 					Name:  RedisTLSClientCertEnvVar,
@@ -310,12 +321,120 @@ func expectedRateLimitContainerEnv(rateLimit *egcfgv1a1.RateLimit, rateLimitDepl
 	return resource.ExpectedProxyContainerEnv(rateLimitDeployment.Container, env)
 }
 
-// Validate the ratelimit tls secret validating.
+// expectedRateLimitBackendEnv returns the rateLimit container envs of the database backend.
+func expectedRateLimitBackendEnv(backend *egcfgv1a1.RateLimitDatabaseBackend) []corev1.EnvVar {
+	if backend.Type == egcfgv1a1.MemcachedBackendType {
+		return []corev1.EnvVar{
+			{
+				Name:  BackendTypeEnvVar,
+				Value: "memcache",
+			},
+			{
+				Name:  MemcacheHostPortEnvVar,
+				Value: strings.Join(backend.Memcached.URLs, ","),
+			},
+		}
+	}
+
+	redis := backend.Redis
+	env := []corev1.EnvVar{
+		{
+			Name:  RedisSocketTypeEnvVar,
+			Value: "tcp",
+		},
+	}
+
+	switch {
+	case redis.Type != nil && *redis.Type == egcfgv1a1.RedisTypeSentinel:
+		// The sentinel url is the name of the master followed by the sentinels.
+		env = append(env, []corev1.EnvVar{
+			{
+				Name:  RedisTypeEnvVar,
+				Value: "SENTINEL",
+			},
+			{
+				Name:  RedisURLEnvVar,
+				Value: strings.Join(append([]string{redis.Sentinel.MasterName}, redis.Sentinel.URLs...), ","),
+			},
+		}...)
+	case redis.Type != nil && *redis.Type == egcfgv1a1.RedisTypeCluster:
+		env = append(env, []corev1.EnvVar{
+			{
+				Name:  RedisTypeEnvVar,
+				Value: "CLUSTER",
+			},
+			{
+				Name:  RedisURLEnvVar,
+				Value: strings.Join(redis.Cluster.URLs, ","),
+			},
+			{
+				Name:  RedisPipelineWindowEnvVar,
+				Value: redisClusterPipelineWindow,
+			},
+		}...)
+	default:
+		env = append(env, corev1.EnvVar{
+			Name:  RedisURLEnvVar,
+			Value: redis.URL,
+		})
+	}
+
+	if redis.Auth != nil {
+		// The password is read from its secret, and referenced by the auth
+		// of the rate limit service, prefixed by the name of the user if any.
+		auth := fmt.Sprintf("$(%s)", RedisPasswordEnvVar)
+		if redis.Auth.Username != nil {
+			auth = *redis.Auth.Username + ":" + auth
+		}
+		env = append(env, []corev1.EnvVar{
+			{
+				Name: RedisPasswordEnvVar,
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: string(redis.Auth.PasswordRef.Name),
+						},
+						Key: RedisPasswordSecretKey,
+					},
+				},
+			},
+			{
+				Name:  RedisAuthEnvVar,
+				Value: auth,
+			},
+		}...)
+	}
+
+	return env
+}
+
+// Validate the ratelimit tls and redis auth secrets validating.
 func Validate(ctx context.Context, client client.Client, gateway *egcfgv1a1.EnvoyGateway, namespace string) error {
-	if gateway.RateLimit.Backend.Redis.TLS != nil && gateway.RateLimit.Backend.Redis.TLS.CertificateRef != nil {
-		certificateRef := gateway.RateLimit.Backend.Redis.TLS.CertificateRef
-		_, _, err := kubernetes.ValidateSecretObjectReference(ctx, client, certificateRef, namespace)
-		return err
+	redis := gateway.RateLimit.Backend.Redis
+	if redis == nil {
+		return nil
+	}
+
+	if redis.TLS != nil && redis.TLS.CertificateRef != nil {
+		certificateRef := redis.TLS.CertificateRef
+		if _, _, err := kubernetes.ValidateSecretObjectReference(ctx, client, certificateRef, namespace); err != nil {
+			return err
+		}
+	}
+
+	if redis.Auth != nil {
+		// The password is read from the environment of the rate limit service,
+		// so its secret must be in the namespace of the rate limit service.
+		secret, secretNamespace, err := kubernetes.ValidateSecretObjectReference(ctx, client, &redis.Auth.PasswordRef, namespace)
+		if err != nil {
+			return err
+		}
+		if secretNamespace != namespace {
+			return fmt.Errorf("redis password Secret %s must be in namespace %s", secret.Name, namespace)
+		}
+		if _, ok := secret.Data[RedisPasswordSecretKey]; !ok {
+			return fmt.Errorf("redis password Secret %s/%s has no %q key", secretNamespace, secret.Name, RedisPasswordSecretKey)
+		}
 	}
 
 	return nil
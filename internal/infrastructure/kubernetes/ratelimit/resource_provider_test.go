@@ -24,9 +24,9 @@ import (
 	"github.com/envoyproxy/gateway/internal/envoygateway/config"
 )
 
-const (
-	// RedisAuthEnvVar is the redis auth.
-	RedisAuthEnvVar = "REDIS_AUTH"
+var (
+	redisSentinelType = egcfgv1a1.RedisTypeSentinel
+	redisClusterType  = egcfgv1a1.RedisTypeCluster
 )
 
 var ownerReferenceUID = map[string]types.UID{
@@ -480,6 +480,55 @@ func TestDeployment(t *testing.T) {
 				},
 			},
 		},
+		{
+			caseName: "redis-sentinel-auth",
+			rateLimit: &egcfgv1a1.RateLimit{
+				Backend: egcfgv1a1.RateLimitDatabaseBackend{
+					Type: egcfgv1a1.RedisBackendType,
+					Redis: &egcfgv1a1.RateLimitRedisSettings{
+						Type: &redisSentinelType,
+						Sentinel: &egcfgv1a1.RedisSentinelSettings{
+							MasterName: "mymaster",
+							URLs:       []string{"sentinel-0.redis.svc:26379", "sentinel-1.redis.svc:26379"},
+						},
+						Auth: &egcfgv1a1.RedisAuth{
+							Username: pointer.String("ratelimit"),
+							PasswordRef: gwapiv1b1.SecretObjectReference{
+								Name: "redis-password",
+							},
+						},
+					},
+				},
+			},
+			deploy: cfg.EnvoyGateway.GetEnvoyGatewayProvider().GetEnvoyGatewayKubeProvider().RateLimitDeployment,
+		},
+		{
+			caseName: "redis-cluster",
+			rateLimit: &egcfgv1a1.RateLimit{
+				Backend: egcfgv1a1.RateLimitDatabaseBackend{
+					Type: egcfgv1a1.RedisBackendType,
+					Redis: &egcfgv1a1.RateLimitRedisSettings{
+						Type: &redisClusterType,
+						Cluster: &egcfgv1a1.RedisClusterSettings{
+							URLs: []string{"redis-0.redis.svc:6379", "redis-1.redis.svc:6379"},
+						},
+					},
+				},
+			},
+			deploy: cfg.EnvoyGateway.GetEnvoyGatewayProvider().GetEnvoyGatewayKubeProvider().RateLimitDeployment,
+		},
+		{
+			caseName: "memcached",
+			rateLimit: &egcfgv1a1.RateLimit{
+				Backend: egcfgv1a1.RateLimitDatabaseBackend{
+					Type: egcfgv1a1.MemcachedBackendType,
+					Memcached: &egcfgv1a1.RateLimitMemcachedSettings{
+						URLs: []string{"memcached-0.memcached.svc:11211", "memcached-1.memcached.svc:11211"},
+					},
+				},
+			},
+			deploy: cfg.EnvoyGateway.GetEnvoyGatewayProvider().GetEnvoyGatewayKubeProvider().RateLimitDeployment,
+		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.caseName, func(t *testing.T) {
@@ -9,12 +9,16 @@ import (
 	"errors"
 	"net"
 	"reflect"
+	"regexp"
+	"strings"
 
 	"github.com/tetratelabs/multierror"
 	"golang.org/x/exp/slices"
 
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
 
 	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
 	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
@@ -33,31 +37,44 @@ var (
 	ErrHTTPRouteHostnameEmpty        = errors.New("field Hostname must be specified")
 	ErrHTTPRouteMatchEmpty           = errors.New("either PathMatch, HeaderMatches or QueryParamMatches fields must be specified")
 	ErrDestinationNameEmpty          = errors.New("field Name must be specified")
-	ErrDestEndpointHostInvalid       = errors.New("field Address must be a valid IP address")
+	ErrDestEndpointHostInvalid       = errors.New("field Address must be a valid IP address or hostname")
 	ErrDestEndpointPortInvalid       = errors.New("field Port specified is invalid")
 	ErrStringMatchConditionInvalid   = errors.New("only one of the Exact, Prefix, SafeRegex or Distinct fields must be set")
 	ErrStringMatchNameIsEmpty        = errors.New("field Name must be specified")
 	ErrDirectResponseStatusInvalid   = errors.New("only HTTP status codes 100 - 599 are supported for DirectResponse")
-	ErrRedirectUnsupportedStatus     = errors.New("only HTTP status codes 301 and 302 are supported for redirect filters")
+	ErrRedirectUnsupportedStatus     = errors.New("only HTTP status codes 301, 302, 303, 307 and 308 are supported for redirect filters")
 	ErrRedirectUnsupportedScheme     = errors.New("only http and https are supported for the scheme in redirect filters")
-	ErrHTTPPathModifierDoubleReplace = errors.New("redirect filter cannot have a path modifier that supplies both fullPathReplace and prefixMatchReplace")
-	ErrHTTPPathModifierNoReplace     = errors.New("redirect filter cannot have a path modifier that does not supply either fullPathReplace or prefixMatchReplace")
+	ErrHTTPPathModifierDoubleReplace = errors.New("redirect filter cannot have a path modifier that supplies more than one of fullPathReplace, prefixMatchReplace and regexMatchReplace")
+	ErrHTTPPathModifierNoReplace     = errors.New("redirect filter cannot have a path modifier that does not supply either fullPathReplace, prefixMatchReplace or regexMatchReplace")
+	ErrRegexMatchReplaceInvalid      = errors.New("regexMatchReplace must supply a valid regular expression pattern")
+	ErrURLRewriteMultipleHostnames   = errors.New("url rewrite cannot set more than one of hostname, hostnameFromHeader and autoHostname")
+	ErrMirrorDestinationEmpty        = errors.New("field Destination must be specified for a mirror policy")
+	ErrMirrorPercentageInvalid       = errors.New("field Percentage must be between 0 and 100 for a mirror policy")
 	ErrAddHeaderEmptyName            = errors.New("header modifier filter cannot configure a header without a name to be added")
 	ErrAddHeaderDuplicate            = errors.New("header modifier filter attempts to add the same header more than once (case insensitive)")
 	ErrRemoveHeaderDuplicate         = errors.New("header modifier filter attempts to remove the same header more than once (case insensitive)")
 	ErrRequestAuthenRequiresJwt      = errors.New("jwt field is required when request authentication is set")
+	ErrLocalReplyMapperNameEmpty     = errors.New("field Name must be specified for a local reply mapper")
+	ErrLocalReplyStatusCodesEmpty    = errors.New("field StatusCodes must be specified with at least a single status code")
+	ErrLocalReplyStatusInvalid       = errors.New("only HTTP status codes 100 - 599 are supported for local replies")
+	ErrL4DestinationsConflict        = errors.New("only one of the Destination and WeightedDestinations fields can be set")
+	ErrWeightedDestinationEmpty      = errors.New("field Destination must be specified for a weighted destination")
+	ErrWeightedDestinationWeight     = errors.New("field Weight must be greater than 0 for a weighted destination")
+	ErrMaxConnectAttemptsInvalid     = errors.New("field MaxConnectAttempts must be greater than 0")
+	ErrDescriptorSetEmpty            = errors.New("field DescriptorSet must be specified for a gRPC-JSON transcoder")
+	ErrTranscoderServicesEmpty       = errors.New("field Services must be specified with at least a single service for a gRPC-JSON transcoder")
 )
 
 // Xds holds the intermediate representation of a Gateway and is
 // used by the xDS Translator to convert it into xDS resources.
 // +k8s:deepcopy-gen=true
 type Xds struct {
-	// AccessLog configuration for the gateway.
-	AccessLog *AccessLog `json:"accessLog,omitempty" yaml:"accessLog,omitempty"`
 	// Tracing configuration for the gateway.
 	Tracing *Tracing `json:"tracing,omitempty" yaml:"tracing,omitempty"`
 	// Metrics configuration for the gateway.
 	Metrics *Metrics `json:"metrics,omitempty" yaml:"metrics,omitempty"`
+	// ConnectionLimit configuration for the listeners of the gateway.
+	ConnectionLimit *ConnectionLimit `json:"connectionLimit,omitempty" yaml:"connectionLimit,omitempty"`
 	// HTTP listeners exposed by the gateway.
 	HTTP []*HTTPListener `json:"http,omitempty" yaml:"http,omitempty"`
 	// TCP Listeners exposed by the gateway.
@@ -66,6 +83,17 @@ type Xds struct {
 	UDP []*UDPListener `json:"udp,omitempty" yaml:"udp,omitempty"`
 	// EnvoyPatchPolicies is the intermediate representation of the EnvoyPatchPolicy resource
 	EnvoyPatchPolicies []*EnvoyPatchPolicy `json:"envoyPatchPolicies,omitempty" yaml:"envoyPatchPolicies,omitempty"`
+	// ProxyTopology holds the endpoints of the Envoy proxies of the gateway,
+	// used for zone aware routing.
+	ProxyTopology *ProxyTopology `json:"proxyTopology,omitempty" yaml:"proxyTopology,omitempty"`
+}
+
+// ProxyTopology holds the endpoints of the Envoy proxies of a gateway. Envoy uses
+// them as its local cluster, to route the requests to the endpoints in its own zone.
+// +k8s:deepcopy-gen=true
+type ProxyTopology struct {
+	// Endpoints are the ready Envoy proxies.
+	Endpoints []*DestinationEndpoint `json:"endpoints,omitempty" yaml:"endpoints,omitempty"`
 }
 
 // Equal implements the Comparable interface used by watchable.DeepEqual to skip unnecessary updates.
@@ -172,8 +200,13 @@ type HTTPListener struct {
 	TLS []*TLSListenerConfig `json:"tls,omitempty" yaml:"tls,omitempty"`
 	// Routes associated with HTTP traffic to the service.
 	Routes []*HTTPRoute `json:"routes,omitempty" yaml:"routes,omitempty"`
-	// IsHTTP2 is set if the upstream client as well as the downstream server are configured to serve HTTP2 traffic.
+	// IsHTTP2 is set if the listener serves gRPC routes, the gRPC filters are then enabled on the listener.
+	// The protocol features of each route are configured on the route itself.
 	IsHTTP2 bool `json:"isHTTP2" yaml:"isHTTP2"`
+	// LocalReply defines how the responses generated locally by Envoy are rewritten.
+	LocalReply *LocalReply `json:"localReply,omitempty" yaml:"localReply,omitempty"`
+	// AccessLog configuration for the listener.
+	AccessLog *AccessLog `json:"accessLog,omitempty" yaml:"accessLog,omitempty"`
 }
 
 // Validate the fields within the HTTPListener structure
@@ -203,6 +236,67 @@ func (h HTTPListener) Validate() error {
 			errs = multierror.Append(errs, err)
 		}
 	}
+	if h.LocalReply != nil {
+		if err := h.LocalReply.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	return errs
+}
+
+// LocalReply holds the configuration for rewriting the responses generated locally by Envoy.
+// +k8s:deepcopy-gen=true
+type LocalReply struct {
+	// Mappers is the ordered list of rules applied to the local replies.
+	// The first matching mapper is applied.
+	Mappers []*LocalReplyMapper `json:"mappers,omitempty" yaml:"mappers,omitempty"`
+}
+
+// Validate the fields within the LocalReply structure
+func (l LocalReply) Validate() error {
+	var errs error
+	for _, mapper := range l.Mappers {
+		if err := mapper.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	return errs
+}
+
+// LocalReplyMapper holds the match conditions for a local reply and how it is rewritten.
+// +k8s:deepcopy-gen=true
+type LocalReplyMapper struct {
+	// Name of the mapper, unique across the local reply mappers of all the listeners.
+	Name string `json:"name" yaml:"name"`
+	// StatusCodes that the local reply must have for the mapper to be applied.
+	StatusCodes []uint32 `json:"statusCodes" yaml:"statusCodes"`
+	// ResponseFlags optionally restricts the mapper to local replies with any of these response flags.
+	ResponseFlags []string `json:"responseFlags,omitempty" yaml:"responseFlags,omitempty"`
+	// StatusCode overrides the status code of the local reply.
+	StatusCode *uint32 `json:"statusCode,omitempty" yaml:"statusCode,omitempty"`
+	// Body overrides the body of the local reply.
+	Body *string `json:"body,omitempty" yaml:"body,omitempty"`
+	// ContentType of the rewritten local reply.
+	ContentType *string `json:"contentType,omitempty" yaml:"contentType,omitempty"`
+}
+
+// Validate the fields within the LocalReplyMapper structure
+func (l LocalReplyMapper) Validate() error {
+	var errs error
+	if l.Name == "" {
+		errs = multierror.Append(errs, ErrLocalReplyMapperNameEmpty)
+	}
+	if len(l.StatusCodes) == 0 {
+		errs = multierror.Append(errs, ErrLocalReplyStatusCodesEmpty)
+	}
+	for _, code := range l.StatusCodes {
+		if code < 100 || code > 599 {
+			errs = multierror.Append(errs, ErrLocalReplyStatusInvalid)
+		}
+	}
+	if l.StatusCode != nil && (*l.StatusCode < 100 || *l.StatusCode > 599) {
+		errs = multierror.Append(errs, ErrLocalReplyStatusInvalid)
+	}
 	return errs
 }
 
@@ -262,8 +356,8 @@ type HTTPRoute struct {
 	DirectResponse *DirectResponse `json:"directResponse,omitempty" yaml:"directResponse,omitempty"`
 	// Redirections to be returned for this route. Takes precedence over Destinations.
 	Redirect *Redirect `json:"redirect,omitempty" yaml:"redirect,omitempty"`
-	// Destination that requests to this HTTPRoute will be mirrored to
-	Mirror *RouteDestination `json:"mirror,omitempty" yaml:"mirror,omitempty"`
+	// Mirrors is the list of destinations that requests to this HTTPRoute will be mirrored to
+	Mirrors []*MirrorPolicy `json:"mirrors,omitempty" yaml:"mirrors,omitempty"`
 	// Destination associated with this matched route.
 	Destination *RouteDestination `json:"destination,omitempty" yaml:"destination,omitempty"`
 	// Rewrite to be changed for this route.
@@ -275,6 +369,56 @@ type HTTPRoute struct {
 	RequestAuthentication *RequestAuthentication `json:"requestAuthentication,omitempty" yaml:"requestAuthentication,omitempty"`
 	// ExtensionRefs holds unstructured resources that were introduced by an extension and used on the HTTPRoute as extensionRef filters
 	ExtensionRefs []*UnstructuredRef `json:"extensionRefs,omitempty" yaml:"extensionRefs,omitempty"`
+	// DisableWebSocket disables the WebSocket upgrades of the requests on this route.
+	// WebSocket upgrades are never enabled for gRPC routes.
+	DisableWebSocket bool `json:"disableWebSocket,omitempty" yaml:"disableWebSocket,omitempty"`
+	// GRPC is set if this route serves gRPC traffic, which is forwarded over HTTP2 to the backends.
+	GRPC *GRPCFeatures `json:"grpc,omitempty" yaml:"grpc,omitempty"`
+	// DisableAccessLog disables the access logs of the requests on this route.
+	DisableAccessLog bool `json:"disableAccessLog,omitempty" yaml:"disableAccessLog,omitempty"`
+}
+
+// GRPCFeatures holds the gRPC features of a route.
+// +k8s:deepcopy-gen=true
+type GRPCFeatures struct {
+	// DisableWeb disables the translation of the gRPC-Web requests to gRPC.
+	DisableWeb bool `json:"disableWeb,omitempty" yaml:"disableWeb,omitempty"`
+	// DisableStats disables the collection of the gRPC statistics.
+	DisableStats bool `json:"disableStats,omitempty" yaml:"disableStats,omitempty"`
+	// JSONTranscoder transcodes the JSON requests on the route to gRPC.
+	JSONTranscoder *GRPCJSONTranscoder `json:"jsonTranscoder,omitempty" yaml:"jsonTranscoder,omitempty"`
+}
+
+// GRPCJSONTranscoder holds the details of a gRPC-JSON transcoder.
+// +k8s:deepcopy-gen=true
+type GRPCJSONTranscoder struct {
+	// DescriptorSet is the binary protobuf descriptor set of the gRPC services.
+	DescriptorSet []byte `json:"descriptorSet" yaml:"descriptorSet"`
+	// Services are the fully qualified names of the gRPC services that are transcoded.
+	Services []string `json:"services" yaml:"services"`
+	// PrintOptions defines how the gRPC responses are printed as JSON.
+	PrintOptions *GRPCJSONPrintOptions `json:"printOptions,omitempty" yaml:"printOptions,omitempty"`
+}
+
+// Validate the fields within the GRPCJSONTranscoder structure
+func (g GRPCJSONTranscoder) Validate() error {
+	var errs error
+	if len(g.DescriptorSet) == 0 {
+		errs = multierror.Append(errs, ErrDescriptorSetEmpty)
+	}
+	if len(g.Services) == 0 {
+		errs = multierror.Append(errs, ErrTranscoderServicesEmpty)
+	}
+	return errs
+}
+
+// GRPCJSONPrintOptions defines how the gRPC responses are printed as JSON.
+// +k8s:deepcopy-gen=true
+type GRPCJSONPrintOptions struct {
+	AddWhitespace              bool `json:"addWhitespace,omitempty" yaml:"addWhitespace,omitempty"`
+	AlwaysPrintPrimitiveFields bool `json:"alwaysPrintPrimitiveFields,omitempty" yaml:"alwaysPrintPrimitiveFields,omitempty"`
+	AlwaysPrintEnumsAsInts     bool `json:"alwaysPrintEnumsAsInts,omitempty" yaml:"alwaysPrintEnumsAsInts,omitempty"`
+	PreserveProtoFieldNames    bool `json:"preserveProtoFieldNames,omitempty" yaml:"preserveProtoFieldNames,omitempty"`
 }
 
 // UnstructuredRef holds unstructured data for an arbitrary k8s resource introduced by an extension
@@ -353,8 +497,8 @@ func (h HTTPRoute) Validate() error {
 			errs = multierror.Append(errs, err)
 		}
 	}
-	if h.Mirror != nil {
-		if err := h.Mirror.Validate(); err != nil {
+	for _, mirror := range h.Mirrors {
+		if err := mirror.Validate(); err != nil {
 			errs = multierror.Append(errs, err)
 		}
 	}
@@ -418,6 +562,11 @@ func (h HTTPRoute) Validate() error {
 			}
 		}
 	}
+	if h.GRPC != nil && h.GRPC.JSONTranscoder != nil {
+		if err := h.GRPC.JSONTranscoder.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
 	return errs
 }
 
@@ -457,6 +606,29 @@ func (r RouteDestination) Validate() error {
 
 }
 
+// MirrorPolicy holds the details of a destination requests are mirrored to
+// +k8s:deepcopy-gen=true
+type MirrorPolicy struct {
+	// Destination that requests will be mirrored to
+	Destination *RouteDestination `json:"destination,omitempty" yaml:"destination,omitempty"`
+	// Percentage of the requests that are mirrored, all the requests are mirrored if unset
+	Percentage *float32 `json:"percentage,omitempty" yaml:"percentage,omitempty"`
+}
+
+// Validate the fields within the MirrorPolicy structure
+func (m MirrorPolicy) Validate() error {
+	var errs error
+	if m.Destination == nil {
+		errs = multierror.Append(errs, ErrMirrorDestinationEmpty)
+	} else if err := m.Destination.Validate(); err != nil {
+		errs = multierror.Append(errs, err)
+	}
+	if m.Percentage != nil && (*m.Percentage < 0 || *m.Percentage > 100) {
+		errs = multierror.Append(errs, ErrMirrorPercentageInvalid)
+	}
+	return errs
+}
+
 // DestinationEndpoint holds the endpoint details associated with the destination
 // +kubebuilder:object:generate=true
 type DestinationEndpoint struct {
@@ -465,15 +637,37 @@ type DestinationEndpoint struct {
 	// Port on the service to forward the request to.
 	Port uint32 `json:"port" yaml:"port"`
 	// Weight associated with this destination.
-	// Note: Weight is not used in TCP/UDP route.
+	// Note: Weight is not used in TCP/UDP route, see WeightedRouteDestination instead.
 	Weight *uint32 `json:"weight,omitempty" yaml:"weight,omitempty"`
+	// Protocol is the application protocol spoken by the backend, if declared.
+	// Note: Protocol is not used in TCP/UDP route.
+	Protocol AppProtocol `json:"protocol,omitempty" yaml:"protocol,omitempty"`
+	// Zone is the topology zone of the endpoint, if known.
+	Zone *string `json:"zone,omitempty" yaml:"zone,omitempty"`
+	// ZoneHints are the zones the endpoint should serve traffic for,
+	// as allocated by topology aware routing.
+	ZoneHints []string `json:"zoneHints,omitempty" yaml:"zoneHints,omitempty"`
+	// Priority is the failover priority of the endpoint, 0 being the highest.
+	// The endpoints of a priority only receive traffic when the endpoints of
+	// the higher priorities are not healthy enough.
+	// Note: Priority is not used in TCP/UDP route.
+	Priority *uint32 `json:"priority,omitempty" yaml:"priority,omitempty"`
 }
 
+// AppProtocol defines the application protocol spoken by a backend.
+type AppProtocol string
+
+const (
+	// AppProtocolHTTP2 is HTTP/2 over cleartext (h2c), which includes gRPC.
+	AppProtocolHTTP2 AppProtocol = "HTTP2"
+)
+
 // Validate the fields within the DestinationEndpoint structure
 func (d DestinationEndpoint) Validate() error {
 	var errs error
-	// Only support IP hosts for now
-	if ip := net.ParseIP(d.Host); ip == nil {
+	// The hostnames are resolved with DNS, and may be fully qualified with a trailing dot.
+	if ip := net.ParseIP(d.Host); ip == nil &&
+		len(k8svalidation.IsDNS1123Subdomain(strings.TrimSuffix(d.Host, "."))) > 0 {
 		errs = multierror.Append(errs, ErrDestEndpointHostInvalid)
 	}
 	if d.Port == 0 {
@@ -544,12 +738,32 @@ type URLRewrite struct {
 	Path *HTTPPathModifier `json:"path,omitempty" yaml:"path,omitempty"`
 	// Hostname configures the replacement of the request's hostname.
 	Hostname *string `json:"hostname,omitempty" yaml:"hostname,omitempty"`
+	// HostnameFromHeader configures the replacement of the request's hostname
+	// with the value of the named request header.
+	HostnameFromHeader *string `json:"hostnameFromHeader,omitempty" yaml:"hostnameFromHeader,omitempty"`
+	// AutoHostname configures the replacement of the request's hostname
+	// with the DNS name of the upstream host.
+	AutoHostname bool `json:"autoHostname,omitempty" yaml:"autoHostname,omitempty"`
 }
 
 // Validate the fields within the URLRewrite structure
 func (r URLRewrite) Validate() error {
 	var errs error
 
+	hostnames := 0
+	if r.Hostname != nil {
+		hostnames++
+	}
+	if r.HostnameFromHeader != nil {
+		hostnames++
+	}
+	if r.AutoHostname {
+		hostnames++
+	}
+	if hostnames > 1 {
+		errs = multierror.Append(errs, ErrURLRewriteMultipleHostnames)
+	}
+
 	if r.Path != nil {
 		if err := r.Path.Validate(); err != nil {
 			errs = multierror.Append(errs, err)
@@ -572,6 +786,8 @@ type Redirect struct {
 	Port *uint32 `json:"port" yaml:"port"`
 	// Status code configures the redirection response's status code.
 	StatusCode *int32 `json:"statusCode" yaml:"statusCode"`
+	// StripQuery removes the query string of the request from the redirection URL.
+	StripQuery bool `json:"stripQuery,omitempty" yaml:"stripQuery,omitempty"`
 }
 
 // Validate the fields within the Redirect structure
@@ -591,7 +807,9 @@ func (r Redirect) Validate() error {
 	}
 
 	if r.StatusCode != nil {
-		if *r.StatusCode != 301 && *r.StatusCode != 302 {
+		switch *r.StatusCode {
+		case 301, 302, 303, 307, 308:
+		default:
 			errs = multierror.Append(errs, ErrRedirectUnsupportedStatus)
 		}
 	}
@@ -606,23 +824,61 @@ type HTTPPathModifier struct {
 	FullReplace *string `json:"fullReplace" yaml:"fullReplace"`
 	// PrefixMatchReplace provides a string to replace the matched prefix of the request.
 	PrefixMatchReplace *string `json:"prefixMatchReplace" yaml:"prefixMatchReplace"`
+	// RegexMatchReplace provides a regex to match and a substitution to rewrite the path of the request.
+	RegexMatchReplace *RegexMatchReplace `json:"regexMatchReplace,omitempty" yaml:"regexMatchReplace,omitempty"`
 }
 
 // Validate the fields within the HTTPPathModifier structure
 func (r HTTPPathModifier) Validate() error {
 	var errs error
 
-	if r.FullReplace != nil && r.PrefixMatchReplace != nil {
+	replaces := 0
+	if r.FullReplace != nil {
+		replaces++
+	}
+	if r.PrefixMatchReplace != nil {
+		replaces++
+	}
+	if r.RegexMatchReplace != nil {
+		replaces++
+		if err := r.RegexMatchReplace.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+
+	if replaces > 1 {
 		errs = multierror.Append(errs, ErrHTTPPathModifierDoubleReplace)
 	}
 
-	if r.FullReplace == nil && r.PrefixMatchReplace == nil {
+	if replaces == 0 {
 		errs = multierror.Append(errs, ErrHTTPPathModifierNoReplace)
 	}
 
 	return errs
 }
 
+// RegexMatchReplace holds a regular expression and the substitution used to rewrite
+// the portions of the path matching it. Capture groups of the pattern can be referenced
+// in the substitution, e.g. "\1".
+// +k8s:deepcopy-gen=true
+type RegexMatchReplace struct {
+	// Pattern is the RE2 regular expression matched against the path.
+	Pattern string `json:"pattern" yaml:"pattern"`
+	// Substitution replaces the portions of the path matching the pattern.
+	Substitution string `json:"substitution" yaml:"substitution"`
+}
+
+// Validate the fields within the RegexMatchReplace structure
+func (r RegexMatchReplace) Validate() error {
+	var errs error
+
+	if _, err := regexp.Compile(r.Pattern); r.Pattern == "" || err != nil {
+		errs = multierror.Append(errs, ErrRegexMatchReplaceInvalid)
+	}
+
+	return errs
+}
+
 // StringMatch holds the various match conditions.
 // Only one of Exact, Prefix, SafeRegex or Distinct can be set.
 // +k8s:deepcopy-gen=true
@@ -685,6 +941,13 @@ type TCPListener struct {
 	TLS *TLS `json:"tls,omitempty" yaml:"tls,omitempty"`
 	// Destinations associated with TCP traffic to the service.
 	Destination *RouteDestination `json:"destination,omitempty" yaml:"destination,omitempty"`
+	// WeightedDestinations split the TCP traffic between several destinations
+	// according to their weights. It can't be set together with Destination.
+	WeightedDestinations []*WeightedRouteDestination `json:"weightedDestinations,omitempty" yaml:"weightedDestinations,omitempty"`
+	// ProxySettings holds the settings of the TCP proxy of the listener.
+	ProxySettings *TCPProxySettings `json:"proxySettings,omitempty" yaml:"proxySettings,omitempty"`
+	// AccessLog configuration for the listener.
+	AccessLog *AccessLog `json:"accessLog,omitempty" yaml:"accessLog,omitempty"`
 }
 
 // TLS holds information for configuring TLS on a listener
@@ -723,14 +986,63 @@ func (h TCPListener) Validate() error {
 		}
 	}
 
-	if h.Destination != nil {
-		if err := h.Destination.Validate(); err != nil {
+	if err := validateL4Destinations(h.Destination, h.WeightedDestinations); err != nil {
+		errs = multierror.Append(errs, err)
+	}
+	if h.ProxySettings != nil {
+		if err := h.ProxySettings.Validate(); err != nil {
 			errs = multierror.Append(errs, err)
 		}
 	}
 	return errs
 }
 
+// TCPProxySettings holds the settings of the TCP proxy of a TCP listener.
+// +k8s:deepcopy-gen=true
+type TCPProxySettings struct {
+	// IdleTimeout after which a connection without activity is closed.
+	IdleTimeout *metav1.Duration `json:"idleTimeout,omitempty" yaml:"idleTimeout,omitempty"`
+	// MaxConnectionDuration after which a connection is closed.
+	MaxConnectionDuration *metav1.Duration `json:"maxConnectionDuration,omitempty" yaml:"maxConnectionDuration,omitempty"`
+	// MaxConnectAttempts to the backends before the connection is closed.
+	MaxConnectAttempts *uint32 `json:"maxConnectAttempts,omitempty" yaml:"maxConnectAttempts,omitempty"`
+	// AccessLogFlushInterval at which the access logs of open connections are written.
+	AccessLogFlushInterval *metav1.Duration `json:"accessLogFlushInterval,omitempty" yaml:"accessLogFlushInterval,omitempty"`
+	// FlushAccessLogOnConnected writes an access log when the upstream connection is established.
+	FlushAccessLogOnConnected bool `json:"flushAccessLogOnConnected,omitempty" yaml:"flushAccessLogOnConnected,omitempty"`
+	// RateLimit limits the rate of the new connections of the listener.
+	RateLimit *TCPRateLimit `json:"rateLimit,omitempty" yaml:"rateLimit,omitempty"`
+}
+
+// TCPRateLimit holds the rate limits of the new connections of a TCP listener.
+// +k8s:deepcopy-gen=true
+type TCPRateLimit struct {
+	// Global rate limits of the connections, enforced by the rate limit service.
+	Global []*TCPRateLimitRule `json:"global,omitempty" yaml:"global,omitempty"`
+	// Local rate limit of the connections, enforced by each proxy.
+	Local *RateLimitValue `json:"local,omitempty" yaml:"local,omitempty"`
+}
+
+// TCPRateLimitRule holds a global rate limit of the new connections.
+// +k8s:deepcopy-gen=true
+type TCPRateLimitRule struct {
+	// Distinct counts the connections of each client IP address separately,
+	// instead of the connections of all the clients together.
+	Distinct bool `json:"distinct,omitempty" yaml:"distinct,omitempty"`
+	// Limit holds the number of connections allowed per unit of time.
+	Limit *RateLimitValue `json:"limit,omitempty" yaml:"limit,omitempty"`
+}
+
+// Validate the fields within the TCPProxySettings structure
+func (t TCPProxySettings) Validate() error {
+	var errs error
+	if t.MaxConnectAttempts != nil && *t.MaxConnectAttempts == 0 {
+		errs = multierror.Append(errs, ErrMaxConnectAttemptsInvalid)
+	}
+
+	return errs
+}
+
 // TLSInspectorConfig holds the configuration required for inspecting TLS
 // passthrough connections.
 // +k8s:deepcopy-gen=true
@@ -761,6 +1073,15 @@ type UDPListener struct {
 	Port uint32 `json:"port" yaml:"port"`
 	// Destination associated with UDP traffic to the service.
 	Destination *RouteDestination `json:"destination,omitempty" yaml:"destination,omitempty"`
+	// WeightedDestinations split the UDP traffic between several destinations
+	// according to their weights. It can't be set together with Destination.
+	// The endpoints of the destinations are merged into a single cluster, so
+	// their weights must be normalized across the destinations.
+	WeightedDestinations []*WeightedRouteDestination `json:"weightedDestinations,omitempty" yaml:"weightedDestinations,omitempty"`
+	// ProxySettings holds the settings of the UDP proxy of the listener.
+	ProxySettings *UDPProxySettings `json:"proxySettings,omitempty" yaml:"proxySettings,omitempty"`
+	// AccessLog configuration for the listener.
+	AccessLog *AccessLog `json:"accessLog,omitempty" yaml:"accessLog,omitempty"`
 }
 
 // Validate the fields within the UDPListener structure
@@ -775,8 +1096,59 @@ func (h UDPListener) Validate() error {
 	if h.Port == 0 {
 		errs = multierror.Append(errs, ErrListenerPortInvalid)
 	}
-	if h.Destination != nil {
-		if err := h.Destination.Validate(); err != nil {
+	if err := validateL4Destinations(h.Destination, h.WeightedDestinations); err != nil {
+		errs = multierror.Append(errs, err)
+	}
+
+	return errs
+}
+
+// UDPProxySettings holds the settings of the UDP proxy of a UDP listener.
+// +k8s:deepcopy-gen=true
+type UDPProxySettings struct {
+	// SessionIdleTimeout after which a session without activity is closed.
+	SessionIdleTimeout *metav1.Duration `json:"sessionIdleTimeout,omitempty" yaml:"sessionIdleTimeout,omitempty"`
+}
+
+// WeightedRouteDestination holds a destination of a TCP or UDP listener
+// and the weight of the traffic it receives.
+// +k8s:deepcopy-gen=true
+type WeightedRouteDestination struct {
+	// Destination that receives the traffic.
+	Destination *RouteDestination `json:"destination,omitempty" yaml:"destination,omitempty"`
+	// Weight of the destination, relative to the sum of the weights of all
+	// the destinations of the listener.
+	Weight uint32 `json:"weight" yaml:"weight"`
+}
+
+// Validate the fields within the WeightedRouteDestination structure
+func (w WeightedRouteDestination) Validate() error {
+	var errs error
+	if w.Destination == nil {
+		errs = multierror.Append(errs, ErrWeightedDestinationEmpty)
+	} else if err := w.Destination.Validate(); err != nil {
+		errs = multierror.Append(errs, err)
+	}
+	if w.Weight == 0 {
+		errs = multierror.Append(errs, ErrWeightedDestinationWeight)
+	}
+
+	return errs
+}
+
+// validateL4Destinations validates the destinations of a TCP or UDP listener.
+func validateL4Destinations(destination *RouteDestination, weighted []*WeightedRouteDestination) error {
+	var errs error
+	if destination != nil && len(weighted) > 0 {
+		errs = multierror.Append(errs, ErrL4DestinationsConflict)
+	}
+	if destination != nil {
+		if err := destination.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	for _, w := range weighted {
+		if err := w.Validate(); err != nil {
 			errs = multierror.Append(errs, err)
 		}
 	}
@@ -789,6 +1161,8 @@ func (h UDPListener) Validate() error {
 type RateLimit struct {
 	// Global rate limit settings.
 	Global *GlobalRateLimit `json:"global,omitempty" yaml:"global,omitempty"`
+	// Local rate limit settings.
+	Local *LocalRateLimit `json:"local,omitempty" yaml:"local,omitempty"`
 }
 
 // GlobalRateLimit holds the global rate limiting configuration.
@@ -798,15 +1172,32 @@ type GlobalRateLimit struct {
 	Rules []*RateLimitRule `json:"rules,omitempty" yaml:"rules,omitempty"`
 }
 
+// LocalRateLimit holds the local rate limiting configuration.
+// +k8s:deepcopy-gen=true
+type LocalRateLimit struct {
+	// Rules for rate limiting.
+	Rules []*RateLimitRule `json:"rules,omitempty" yaml:"rules,omitempty"`
+}
+
 // RateLimitRule holds the match and limit configuration for ratelimiting.
 // +k8s:deepcopy-gen=true
 type RateLimitRule struct {
 	// HeaderMatches define the match conditions on the request headers for this route.
 	HeaderMatches []*StringMatch `json:"headerMatches" yaml:"headerMatches"`
+	// QueryParamMatches define the match conditions on the query parameters for this route.
+	QueryParamMatches []*StringMatch `json:"queryParamMatches,omitempty" yaml:"queryParamMatches,omitempty"`
+	// JWTClaimMatches define the match conditions on the claims of the JWT of the request
+	// for this route. Only the Exact and Distinct matches are supported.
+	JWTClaimMatches []*StringMatch `json:"jwtClaimMatches,omitempty" yaml:"jwtClaimMatches,omitempty"`
 	// CIDRMatch define the match conditions on the source IP's CIDR for this route.
 	CIDRMatch *CIDRMatch `json:"cidrMatch,omitempty" yaml:"cidrMatch,omitempty"`
 	// Limit holds the rate limit values.
 	Limit *RateLimitValue `json:"limit,omitempty" yaml:"limit,omitempty"`
+	// Cost is the number of hits each request counts as towards the limit.
+	// A cost of 0 counts each request as 1 hit.
+	Cost uint32 `json:"cost,omitempty" yaml:"cost,omitempty"`
+	// ShadowMode means that the limit is evaluated and reported, but not enforced.
+	ShadowMode bool `json:"shadowMode,omitempty" yaml:"shadowMode,omitempty"`
 }
 
 type CIDRMatch struct {
@@ -819,7 +1210,7 @@ type CIDRMatch struct {
 }
 
 func (r *RateLimitRule) IsMatchSet() bool {
-	return len(r.HeaderMatches) != 0 || r.CIDRMatch != nil
+	return len(r.HeaderMatches) != 0 || len(r.QueryParamMatches) != 0 || len(r.JWTClaimMatches) != 0 || r.CIDRMatch != nil
 }
 
 type RateLimitUnit egv1a1.RateLimitUnit
@@ -831,6 +1222,9 @@ type RateLimitValue struct {
 	Requests uint `json:"requests" yaml:"requests"`
 	// Unit of rate limiting.
 	Unit RateLimitUnit `json:"unit" yaml:"unit"`
+	// UnitMultiplier multiplies the unit of rate limiting. A multiplier
+	// of 0 is the same as 1.
+	UnitMultiplier uint32 `json:"unitMultiplier,omitempty" yaml:"unitMultiplier,omitempty"`
 }
 
 // AccessLog holds the access logging configuration.
@@ -839,20 +1233,24 @@ type AccessLog struct {
 	Text          []*TextAccessLog          `json:"text,omitempty" yaml:"text,omitempty"`
 	JSON          []*JSONAccessLog          `json:"json,omitempty" yaml:"json,omitempty"`
 	OpenTelemetry []*OpenTelemetryAccessLog `json:"openTelemetry,omitempty" yaml:"openTelemetry,omitempty"`
+	ALS           []*ALSAccessLog           `json:"als,omitempty" yaml:"als,omitempty"`
+	Stream        []*StreamAccessLog        `json:"stream,omitempty" yaml:"stream,omitempty"`
 }
 
 // TextAccessLog holds the configuration for text access logging.
 // +k8s:deepcopy-gen=true
 type TextAccessLog struct {
-	Format *string `json:"format,omitempty" yaml:"format,omitempty"`
-	Path   string  `json:"path" yaml:"path"`
+	Format *string          `json:"format,omitempty" yaml:"format,omitempty"`
+	Path   string           `json:"path" yaml:"path"`
+	Filter *AccessLogFilter `json:"filter,omitempty" yaml:"filter,omitempty"`
 }
 
 // JSONAccessLog holds the configuration for JSON access logging.
 // +k8s:deepcopy-gen=true
 type JSONAccessLog struct {
-	JSON map[string]string `json:"json,omitempty" yaml:"json,omitempty"`
-	Path string            `json:"path" yaml:"path"`
+	JSON   map[string]string `json:"json,omitempty" yaml:"json,omitempty"`
+	Path   string            `json:"path" yaml:"path"`
+	Filter *AccessLogFilter  `json:"filter,omitempty" yaml:"filter,omitempty"`
 }
 
 // OpenTelemetryAccessLog holds the configuration for OpenTelemetry access logging.
@@ -863,6 +1261,102 @@ type OpenTelemetryAccessLog struct {
 	Host       string            `json:"host" yaml:"host"`
 	Port       uint32            `json:"port" yaml:"port"`
 	Resources  map[string]string `json:"resources,omitempty" yaml:"resources,omitempty"`
+	Filter     *AccessLogFilter  `json:"filter,omitempty" yaml:"filter,omitempty"`
+}
+
+// StreamAccessLogType is the standard stream of Envoy an access log is written to.
+type StreamAccessLogType string
+
+const (
+	// StreamAccessLogTypeStdout writes the access logs to the standard output.
+	StreamAccessLogTypeStdout StreamAccessLogType = "Stdout"
+	// StreamAccessLogTypeStderr writes the access logs to the standard error.
+	StreamAccessLogTypeStderr StreamAccessLogType = "Stderr"
+)
+
+// StreamAccessLog holds the configuration for the access logging to the
+// standard output or error of Envoy. The access logs are formatted with the
+// JSON format if set, and with the text format otherwise.
+// +k8s:deepcopy-gen=true
+type StreamAccessLog struct {
+	Type   StreamAccessLogType `json:"type" yaml:"type"`
+	Text   *string             `json:"text,omitempty" yaml:"text,omitempty"`
+	JSON   map[string]string   `json:"json,omitempty" yaml:"json,omitempty"`
+	Filter *AccessLogFilter    `json:"filter,omitempty" yaml:"filter,omitempty"`
+}
+
+// ALSAccessLogType is the type of the entries of an ALS access log.
+type ALSAccessLogType string
+
+const (
+	// ALSAccessLogTypeHTTP sends the HTTP access log entries.
+	ALSAccessLogTypeHTTP ALSAccessLogType = "HTTP"
+	// ALSAccessLogTypeTCP sends the TCP access log entries.
+	ALSAccessLogTypeTCP ALSAccessLogType = "TCP"
+)
+
+// ALSAccessLog holds the configuration for the gRPC Access Log Service (ALS) access logging.
+// +k8s:deepcopy-gen=true
+type ALSAccessLog struct {
+	LogName             string                  `json:"logName" yaml:"logName"`
+	Type                ALSAccessLogType        `json:"type" yaml:"type"`
+	Host                string                  `json:"host" yaml:"host"`
+	Port                uint32                  `json:"port" yaml:"port"`
+	BufferFlushInterval *metav1.Duration        `json:"bufferFlushInterval,omitempty" yaml:"bufferFlushInterval,omitempty"`
+	BufferSizeBytes     *uint32                 `json:"bufferSizeBytes,omitempty" yaml:"bufferSizeBytes,omitempty"`
+	HTTP                *ALSAccessLogHTTPConfig `json:"http,omitempty" yaml:"http,omitempty"`
+	Filter              *AccessLogFilter        `json:"filter,omitempty" yaml:"filter,omitempty"`
+}
+
+// ALSAccessLogHTTPConfig holds the additional headers of the HTTP ALS access log entries.
+// +k8s:deepcopy-gen=true
+type ALSAccessLogHTTPConfig struct {
+	RequestHeaders   []string `json:"requestHeaders,omitempty" yaml:"requestHeaders,omitempty"`
+	ResponseHeaders  []string `json:"responseHeaders,omitempty" yaml:"responseHeaders,omitempty"`
+	ResponseTrailers []string `json:"responseTrailers,omitempty" yaml:"responseTrailers,omitempty"`
+}
+
+// AccessLogFilter holds the conditions of the requests logged by an access log.
+// +k8s:deepcopy-gen=true
+type AccessLogFilter struct {
+	// Name of the filter, unique across the access log filters of all the
+	// listeners. It names the runtime keys of the conditions of the filter.
+	Name string `json:"name" yaml:"name"`
+	// Or logs the requests matching any of the conditions, instead of all of them.
+	Or         bool                        `json:"or,omitempty" yaml:"or,omitempty"`
+	Conditions []*AccessLogFilterCondition `json:"conditions,omitempty" yaml:"conditions,omitempty"`
+}
+
+// AccessLogFilterCondition holds a condition of an access log filter.
+// Only one of the fields is set.
+// +k8s:deepcopy-gen=true
+type AccessLogFilterCondition struct {
+	// StatusCode matches the response status codes in the inclusive range.
+	StatusCode *StatusCodeRange `json:"statusCode,omitempty" yaml:"statusCode,omitempty"`
+	// MinDuration matches the requests lasting at least the duration.
+	MinDuration *metav1.Duration `json:"minDuration,omitempty" yaml:"minDuration,omitempty"`
+	// ResponseFlags matches the requests with any of the Envoy response flags.
+	ResponseFlags []string `json:"responseFlags,omitempty" yaml:"responseFlags,omitempty"`
+	// Header matches the requests with the header.
+	Header *AccessLogHeaderMatch `json:"header,omitempty" yaml:"header,omitempty"`
+	// SamplePercent matches a sample of the requests, as a percentage.
+	SamplePercent *uint32 `json:"samplePercent,omitempty" yaml:"samplePercent,omitempty"`
+}
+
+// StatusCodeRange holds an inclusive range of status codes. A zero bound
+// leaves the range open on that side.
+// +k8s:deepcopy-gen=true
+type StatusCodeRange struct {
+	Min uint32 `json:"min,omitempty" yaml:"min,omitempty"`
+	Max uint32 `json:"max,omitempty" yaml:"max,omitempty"`
+}
+
+// AccessLogHeaderMatch holds a request header matched by an access log filter.
+// The header only needs to be present if the value is nil.
+// +k8s:deepcopy-gen=true
+type AccessLogHeaderMatch struct {
+	Name  string  `json:"name" yaml:"name"`
+	Value *string `json:"value,omitempty" yaml:"value,omitempty"`
 }
 
 // EnvoyPatchPolicy defines the intermediate representation of the EnvoyPatchPolicy resource.
@@ -921,3 +1415,10 @@ type Tracing struct {
 type Metrics struct {
 	EnableVirtualHostStats bool `json:"enableVirtualHostStats"`
 }
+
+// ConnectionLimit defines the maximum number of connections accepted by each
+// filter chain of the listeners of the gateway.
+// +k8s:deepcopy-gen=true
+type ConnectionLimit struct {
+	MaxConnections uint64 `json:"maxConnections" yaml:"maxConnections"`
+}
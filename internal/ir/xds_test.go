@@ -7,10 +7,12 @@ package ir
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
 )
@@ -111,6 +113,28 @@ var (
 		TLS:         &TLS{Passthrough: &TLSInspectorConfig{SNIs: []string{}}},
 		Destination: &happyRouteDestination,
 	}
+	proxySettingsTCPListener = TCPListener{
+		Name:        "proxy-settings",
+		Address:     "0.0.0.0",
+		Port:        80,
+		Destination: &happyRouteDestination,
+		ProxySettings: &TCPProxySettings{
+			IdleTimeout:               &metav1.Duration{Duration: time.Hour},
+			MaxConnectionDuration:     &metav1.Duration{Duration: 24 * time.Hour},
+			MaxConnectAttempts:        ptrTo(uint32(3)),
+			AccessLogFlushInterval:    &metav1.Duration{Duration: time.Minute},
+			FlushAccessLogOnConnected: true,
+		},
+	}
+	invalidProxySettingsTCPListener = TCPListener{
+		Name:        "invalid-proxy-settings",
+		Address:     "0.0.0.0",
+		Port:        80,
+		Destination: &happyRouteDestination,
+		ProxySettings: &TCPProxySettings{
+			MaxConnectAttempts: ptrTo(uint32(0)),
+		},
+	}
 
 	// UDPListener
 	happyUDPListener = UDPListener{
@@ -136,6 +160,46 @@ var (
 		Port:        0,
 		Destination: &happyRouteDestination,
 	}
+	weightedUDPListener = UDPListener{
+		Name:    "weighted",
+		Address: "0.0.0.0",
+		Port:    80,
+		WeightedDestinations: []*WeightedRouteDestination{
+			{
+				Destination: &happyRouteDestination,
+				Weight:      80,
+			},
+			{
+				Destination: &happyRouteDestination,
+				Weight:      20,
+			},
+		},
+	}
+	invalidWeightUDPListener = UDPListener{
+		Name:    "invalid-weight",
+		Address: "0.0.0.0",
+		Port:    80,
+		WeightedDestinations: []*WeightedRouteDestination{
+			{
+				Destination: &happyRouteDestination,
+			},
+			{
+				Weight: 20,
+			},
+		},
+	}
+	conflictingDestinationsUDPListener = UDPListener{
+		Name:        "conflicting-destinations",
+		Address:     "0.0.0.0",
+		Port:        80,
+		Destination: &happyRouteDestination,
+		WeightedDestinations: []*WeightedRouteDestination{
+			{
+				Destination: &happyRouteDestination,
+				Weight:      20,
+			},
+		},
+	}
 
 	// HTTPRoute
 	happyHTTPRoute = HTTPRoute{
@@ -190,6 +254,23 @@ var (
 			StatusCode: ptrTo(int32(301)),
 		},
 	}
+	regexRedirectHTTPRoute = HTTPRoute{
+		Name:     "regex-redirect",
+		Hostname: "*",
+		PathMatch: &StringMatch{
+			Prefix: ptrTo("/v1"),
+		},
+		Redirect: &Redirect{
+			Path: &HTTPPathModifier{
+				RegexMatchReplace: &RegexMatchReplace{
+					Pattern:      "^/v1/(.*)$",
+					Substitution: "/v2/\\1",
+				},
+			},
+			StatusCode: ptrTo(int32(308)),
+			StripQuery: true,
+		},
+	}
 	// A direct response error is used when an invalid filter type is supplied
 	invalidFilterHTTPRoute = HTTPRoute{
 		Name:     "filter-error",
@@ -234,6 +315,23 @@ var (
 			StatusCode: ptrTo(int32(301)),
 		},
 	}
+	redirectFilterBadRegex = HTTPRoute{
+		Name:     "redirect",
+		Hostname: "*",
+		PathMatch: &StringMatch{
+			Exact: ptrTo("redirect"),
+		},
+		Redirect: &Redirect{
+			Path: &HTTPPathModifier{
+				PrefixMatchReplace: ptrTo("/redirect"),
+				RegexMatchReplace: &RegexMatchReplace{
+					Pattern:      "^/v1/(.*$",
+					Substitution: "/v2/\\1",
+				},
+			},
+			StatusCode: ptrTo(int32(307)),
+		},
+	}
 	directResponseBadStatus = HTTPRoute{
 		Name:     "redirect",
 		Hostname: "*",
@@ -260,6 +358,35 @@ var (
 		},
 	}
 
+	urlRewriteRegexHTTPRoute = HTTPRoute{
+		Name:     "rewrite",
+		Hostname: "*",
+		PathMatch: &StringMatch{
+			Prefix: ptrTo("/api/v1/users/"),
+		},
+		URLRewrite: &URLRewrite{
+			Path: &HTTPPathModifier{
+				RegexMatchReplace: &RegexMatchReplace{
+					Pattern:      "^/api/v1/users/(.*)$",
+					Substitution: "/users/\\1",
+				},
+			},
+		},
+	}
+
+	urlRewriteMultipleHostnamesHTTPRoute = HTTPRoute{
+		Name:     "rewrite",
+		Hostname: "*",
+		PathMatch: &StringMatch{
+			Exact: ptrTo("rewrite"),
+		},
+		URLRewrite: &URLRewrite{
+			Hostname:           ptrTo("rewrite.example.com"),
+			HostnameFromHeader: ptrTo("x-tenant-host"),
+			AutoHostname:       true,
+		},
+	}
+
 	urlRewriteFilterBadPath = HTTPRoute{
 		Name:     "rewrite",
 		Hostname: "*",
@@ -456,7 +583,28 @@ var (
 		PathMatch: &StringMatch{
 			Exact: ptrTo("mirrorfilter"),
 		},
-		Mirror: &happyRouteDestination,
+		Mirrors: []*MirrorPolicy{
+			{
+				Destination: &happyRouteDestination,
+			},
+		},
+	}
+	requestMirrorPercentageFilter = HTTPRoute{
+		Name:     "mirrorfilter",
+		Hostname: "*",
+		PathMatch: &StringMatch{
+			Exact: ptrTo("mirrorfilter"),
+		},
+		Mirrors: []*MirrorPolicy{
+			{
+				Destination: &happyRouteDestination,
+				Percentage:  ptrTo(float32(10)),
+			},
+			{
+				Destination: &happyRouteDestination,
+				Percentage:  ptrTo(float32(150)),
+			},
+		},
 	}
 
 	// RouteDestination
@@ -580,6 +728,44 @@ func TestValidateHTTPListener(t *testing.T) {
 			input: invalidRouteMatchHTTPListener,
 			want:  []error{ErrHTTPRouteMatchEmpty},
 		},
+		{
+			name: "local reply",
+			input: HTTPListener{
+				Name:      "local-reply",
+				Address:   "0.0.0.0",
+				Port:      80,
+				Hostnames: []string{"example.com"},
+				Routes:    []*HTTPRoute{&happyHTTPRoute},
+				LocalReply: &LocalReply{
+					Mappers: []*LocalReplyMapper{{
+						Name:          "local-reply/mapper/0",
+						StatusCodes:   []uint32{404, 503},
+						ResponseFlags: []string{"NR"},
+						StatusCode:    ptrTo(uint32(200)),
+						Body:          ptrTo("not found"),
+					}},
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "invalid local reply",
+			input: HTTPListener{
+				Name:      "invalid-local-reply",
+				Address:   "0.0.0.0",
+				Port:      80,
+				Hostnames: []string{"example.com"},
+				Routes:    []*HTTPRoute{&happyHTTPRoute},
+				LocalReply: &LocalReply{
+					Mappers: []*LocalReplyMapper{
+						{Name: "invalid-local-reply/mapper/0", StatusCode: ptrTo(uint32(200))},
+						{Name: "invalid-local-reply/mapper/1", StatusCodes: []uint32{404}, StatusCode: ptrTo(uint32(600))},
+						{StatusCodes: []uint32{404}},
+					},
+				},
+			},
+			want: []error{ErrLocalReplyStatusCodesEmpty, ErrLocalReplyStatusInvalid, ErrLocalReplyMapperNameEmpty},
+		},
 	}
 	for _, test := range tests {
 		test := test
@@ -627,6 +813,16 @@ func TestValidateTCPListener(t *testing.T) {
 			input: invalidSNITCPListenerTLSPassthrough,
 			want:  []error{ErrTCPListenerSNIsEmpty},
 		},
+		{
+			name:  "tcp proxy settings",
+			input: proxySettingsTCPListener,
+			want:  nil,
+		},
+		{
+			name:  "tcp invalid proxy settings",
+			input: invalidProxySettingsTCPListener,
+			want:  []error{ErrMaxConnectAttemptsInvalid},
+		},
 	}
 	for _, test := range tests {
 		test := test
@@ -784,6 +980,21 @@ func TestValidateUDPListener(t *testing.T) {
 			input: invalidPortUDPListenerT,
 			want:  []error{ErrListenerPortInvalid},
 		},
+		{
+			name:  "udp weighted destinations",
+			input: weightedUDPListener,
+			want:  nil,
+		},
+		{
+			name:  "udp invalid weighted destinations",
+			input: invalidWeightUDPListener,
+			want:  []error{ErrWeightedDestinationWeight, ErrWeightedDestinationEmpty},
+		},
+		{
+			name:  "udp conflicting destinations",
+			input: conflictingDestinationsUDPListener,
+			want:  []error{ErrL4DestinationsConflict},
+		},
 	}
 	for _, test := range tests {
 		test := test
@@ -877,6 +1088,16 @@ func TestValidateHTTPRoute(t *testing.T) {
 			input: redirectFilterBadPath,
 			want:  []error{ErrHTTPPathModifierDoubleReplace},
 		},
+		{
+			name:  "regex-redirect-httproute",
+			input: regexRedirectHTTPRoute,
+			want:  nil,
+		},
+		{
+			name:  "redirect-bad-regex",
+			input: redirectFilterBadRegex,
+			want:  []error{ErrRegexMatchReplaceInvalid, ErrHTTPPathModifierDoubleReplace},
+		},
 		{
 			name:  "direct-response-bad-status",
 			input: directResponseBadStatus,
@@ -887,6 +1108,16 @@ func TestValidateHTTPRoute(t *testing.T) {
 			input: urlRewriteHTTPRoute,
 			want:  nil,
 		},
+		{
+			name:  "rewrite-regex-httproute",
+			input: urlRewriteRegexHTTPRoute,
+			want:  nil,
+		},
+		{
+			name:  "rewrite-multiple-hostnames",
+			input: urlRewriteMultipleHostnamesHTTPRoute,
+			want:  []error{ErrURLRewriteMultipleHostnames},
+		},
 		{
 			name:  "rewrite-bad-path",
 			input: urlRewriteFilterBadPath,
@@ -941,6 +1172,44 @@ func TestValidateHTTPRoute(t *testing.T) {
 			input: requestMirrorFilter,
 			want:  nil,
 		},
+		{
+			name:  "mirror-filter-invalid-percentage",
+			input: requestMirrorPercentageFilter,
+			want:  []error{ErrMirrorPercentageInvalid},
+		},
+		{
+			name: "grpc-json-transcoder",
+			input: HTTPRoute{
+				Name:     "grpc",
+				Hostname: "*",
+				PathMatch: &StringMatch{
+					Prefix: ptrTo("/helloworld.Greeter"),
+				},
+				Destination: &happyRouteDestination,
+				GRPC: &GRPCFeatures{
+					JSONTranscoder: &GRPCJSONTranscoder{
+						DescriptorSet: []byte("descriptor"),
+						Services:      []string{"helloworld.Greeter"},
+					},
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "grpc-json-transcoder-invalid",
+			input: HTTPRoute{
+				Name:     "grpc",
+				Hostname: "*",
+				PathMatch: &StringMatch{
+					Prefix: ptrTo("/helloworld.Greeter"),
+				},
+				Destination: &happyRouteDestination,
+				GRPC: &GRPCFeatures{
+					JSONTranscoder: &GRPCJSONTranscoder{},
+				},
+			},
+			want: []error{ErrDescriptorSetEmpty, ErrTranscoderServicesEmpty},
+		},
 	}
 	for _, test := range tests {
 		test := test
@@ -969,9 +1238,9 @@ func TestValidateRouteDestination(t *testing.T) {
 			want:  nil,
 		},
 		{
-			name: "invalid ip",
+			name: "hostname",
 			input: RouteDestination{
-				Name: "invalid ip",
+				Name: "hostname",
 				Endpoints: []*DestinationEndpoint{
 					{
 						Host: "example.com",
@@ -979,6 +1248,19 @@ func TestValidateRouteDestination(t *testing.T) {
 					},
 				},
 			},
+			want: nil,
+		},
+		{
+			name: "invalid host",
+			input: RouteDestination{
+				Name: "invalid host",
+				Endpoints: []*DestinationEndpoint{
+					{
+						Host: "example_com",
+						Port: 8080,
+					},
+				},
+			},
 			want: ErrDestEndpointHostInvalid,
 		},
 		{
@@ -13,8 +13,74 @@ package ir
 import (
 	"github.com/envoyproxy/gateway/api/config/v1alpha1"
 	apiv1alpha1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ALSAccessLog) DeepCopyInto(out *ALSAccessLog) {
+	*out = *in
+	if in.BufferFlushInterval != nil {
+		in, out := &in.BufferFlushInterval, &out.BufferFlushInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.BufferSizeBytes != nil {
+		in, out := &in.BufferSizeBytes, &out.BufferSizeBytes
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(ALSAccessLogHTTPConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(AccessLogFilter)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ALSAccessLog.
+func (in *ALSAccessLog) DeepCopy() *ALSAccessLog {
+	if in == nil {
+		return nil
+	}
+	out := new(ALSAccessLog)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ALSAccessLogHTTPConfig) DeepCopyInto(out *ALSAccessLogHTTPConfig) {
+	*out = *in
+	if in.RequestHeaders != nil {
+		in, out := &in.RequestHeaders, &out.RequestHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResponseHeaders != nil {
+		in, out := &in.ResponseHeaders, &out.ResponseHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResponseTrailers != nil {
+		in, out := &in.ResponseTrailers, &out.ResponseTrailers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ALSAccessLogHTTPConfig.
+func (in *ALSAccessLogHTTPConfig) DeepCopy() *ALSAccessLogHTTPConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ALSAccessLogHTTPConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AccessLog) DeepCopyInto(out *AccessLog) {
 	*out = *in
@@ -51,6 +117,28 @@ func (in *AccessLog) DeepCopyInto(out *AccessLog) {
 			}
 		}
 	}
+	if in.ALS != nil {
+		in, out := &in.ALS, &out.ALS
+		*out = make([]*ALSAccessLog, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ALSAccessLog)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Stream != nil {
+		in, out := &in.Stream, &out.Stream
+		*out = make([]*StreamAccessLog, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(StreamAccessLog)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessLog.
@@ -63,6 +151,92 @@ func (in *AccessLog) DeepCopy() *AccessLog {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessLogFilter) DeepCopyInto(out *AccessLogFilter) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*AccessLogFilterCondition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(AccessLogFilterCondition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessLogFilter.
+func (in *AccessLogFilter) DeepCopy() *AccessLogFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessLogFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessLogFilterCondition) DeepCopyInto(out *AccessLogFilterCondition) {
+	*out = *in
+	if in.StatusCode != nil {
+		in, out := &in.StatusCode, &out.StatusCode
+		*out = new(StatusCodeRange)
+		**out = **in
+	}
+	if in.MinDuration != nil {
+		in, out := &in.MinDuration, &out.MinDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ResponseFlags != nil {
+		in, out := &in.ResponseFlags, &out.ResponseFlags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Header != nil {
+		in, out := &in.Header, &out.Header
+		*out = new(AccessLogHeaderMatch)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SamplePercent != nil {
+		in, out := &in.SamplePercent, &out.SamplePercent
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessLogFilterCondition.
+func (in *AccessLogFilterCondition) DeepCopy() *AccessLogFilterCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessLogFilterCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessLogHeaderMatch) DeepCopyInto(out *AccessLogHeaderMatch) {
+	*out = *in
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessLogHeaderMatch.
+func (in *AccessLogHeaderMatch) DeepCopy() *AccessLogHeaderMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessLogHeaderMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AddHeader) DeepCopyInto(out *AddHeader) {
 	*out = *in
@@ -78,6 +252,21 @@ func (in *AddHeader) DeepCopy() *AddHeader {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionLimit) DeepCopyInto(out *ConnectionLimit) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionLimit.
+func (in *ConnectionLimit) DeepCopy() *ConnectionLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DestinationEndpoint) DeepCopyInto(out *DestinationEndpoint) {
 	*out = *in
@@ -86,6 +275,21 @@ func (in *DestinationEndpoint) DeepCopyInto(out *DestinationEndpoint) {
 		*out = new(uint32)
 		**out = **in
 	}
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = new(string)
+		**out = **in
+	}
+	if in.ZoneHints != nil {
+		in, out := &in.ZoneHints, &out.ZoneHints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(uint32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DestinationEndpoint.
@@ -165,6 +369,71 @@ func (in *EnvoyPatchPolicyStatus) DeepCopy() *EnvoyPatchPolicyStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCFeatures) DeepCopyInto(out *GRPCFeatures) {
+	*out = *in
+	if in.JSONTranscoder != nil {
+		in, out := &in.JSONTranscoder, &out.JSONTranscoder
+		*out = new(GRPCJSONTranscoder)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCFeatures.
+func (in *GRPCFeatures) DeepCopy() *GRPCFeatures {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCFeatures)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCJSONPrintOptions) DeepCopyInto(out *GRPCJSONPrintOptions) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCJSONPrintOptions.
+func (in *GRPCJSONPrintOptions) DeepCopy() *GRPCJSONPrintOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCJSONPrintOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCJSONTranscoder) DeepCopyInto(out *GRPCJSONTranscoder) {
+	*out = *in
+	if in.DescriptorSet != nil {
+		in, out := &in.DescriptorSet, &out.DescriptorSet
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PrintOptions != nil {
+		in, out := &in.PrintOptions, &out.PrintOptions
+		*out = new(GRPCJSONPrintOptions)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCJSONTranscoder.
+func (in *GRPCJSONTranscoder) DeepCopy() *GRPCJSONTranscoder {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCJSONTranscoder)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GlobalRateLimit) DeepCopyInto(out *GlobalRateLimit) {
 	*out = *in
@@ -221,6 +490,16 @@ func (in *HTTPListener) DeepCopyInto(out *HTTPListener) {
 			}
 		}
 	}
+	if in.LocalReply != nil {
+		in, out := &in.LocalReply, &out.LocalReply
+		*out = new(LocalReply)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AccessLog != nil {
+		in, out := &in.AccessLog, &out.AccessLog
+		*out = new(AccessLog)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPListener.
@@ -246,6 +525,11 @@ func (in *HTTPPathModifier) DeepCopyInto(out *HTTPPathModifier) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.RegexMatchReplace != nil {
+		in, out := &in.RegexMatchReplace, &out.RegexMatchReplace
+		*out = new(RegexMatchReplace)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPPathModifier.
@@ -319,10 +603,16 @@ func (in *HTTPRoute) DeepCopyInto(out *HTTPRoute) {
 		*out = new(Redirect)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Mirror != nil {
-		in, out := &in.Mirror, &out.Mirror
-		*out = new(RouteDestination)
-		(*in).DeepCopyInto(*out)
+	if in.Mirrors != nil {
+		in, out := &in.Mirrors, &out.Mirrors
+		*out = make([]*MirrorPolicy, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(MirrorPolicy)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
 	if in.Destination != nil {
 		in, out := &in.Destination, &out.Destination
@@ -355,6 +645,11 @@ func (in *HTTPRoute) DeepCopyInto(out *HTTPRoute) {
 			}
 		}
 	}
+	if in.GRPC != nil {
+		in, out := &in.GRPC, &out.GRPC
+		*out = new(GRPCFeatures)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRoute.
@@ -419,6 +714,11 @@ func (in *JSONAccessLog) DeepCopyInto(out *JSONAccessLog) {
 			(*out)[key] = val
 		}
 	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(AccessLogFilter)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JSONAccessLog.
@@ -500,6 +800,98 @@ func (in *ListenerPort) DeepCopy() *ListenerPort {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalRateLimit) DeepCopyInto(out *LocalRateLimit) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]*RateLimitRule, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(RateLimitRule)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalRateLimit.
+func (in *LocalRateLimit) DeepCopy() *LocalRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalReply) DeepCopyInto(out *LocalReply) {
+	*out = *in
+	if in.Mappers != nil {
+		in, out := &in.Mappers, &out.Mappers
+		*out = make([]*LocalReplyMapper, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(LocalReplyMapper)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalReply.
+func (in *LocalReply) DeepCopy() *LocalReply {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalReply)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalReplyMapper) DeepCopyInto(out *LocalReplyMapper) {
+	*out = *in
+	if in.StatusCodes != nil {
+		in, out := &in.StatusCodes, &out.StatusCodes
+		*out = make([]uint32, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResponseFlags != nil {
+		in, out := &in.ResponseFlags, &out.ResponseFlags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StatusCode != nil {
+		in, out := &in.StatusCode, &out.StatusCode
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Body != nil {
+		in, out := &in.Body, &out.Body
+		*out = new(string)
+		**out = **in
+	}
+	if in.ContentType != nil {
+		in, out := &in.ContentType, &out.ContentType
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalReplyMapper.
+func (in *LocalReplyMapper) DeepCopy() *LocalReplyMapper {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalReplyMapper)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Metrics) DeepCopyInto(out *Metrics) {
 	*out = *in
@@ -515,6 +907,31 @@ func (in *Metrics) DeepCopy() *Metrics {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MirrorPolicy) DeepCopyInto(out *MirrorPolicy) {
+	*out = *in
+	if in.Destination != nil {
+		in, out := &in.Destination, &out.Destination
+		*out = new(RouteDestination)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Percentage != nil {
+		in, out := &in.Percentage, &out.Percentage
+		*out = new(float32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MirrorPolicy.
+func (in *MirrorPolicy) DeepCopy() *MirrorPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(MirrorPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpenTelemetryAccessLog) DeepCopyInto(out *OpenTelemetryAccessLog) {
 	*out = *in
@@ -537,6 +954,11 @@ func (in *OpenTelemetryAccessLog) DeepCopyInto(out *OpenTelemetryAccessLog) {
 			(*out)[key] = val
 		}
 	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(AccessLogFilter)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenTelemetryAccessLog.
@@ -606,6 +1028,32 @@ func (in *ProxyListener) DeepCopy() *ProxyListener {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxyTopology) DeepCopyInto(out *ProxyTopology) {
+	*out = *in
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = make([]*DestinationEndpoint, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(DestinationEndpoint)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyTopology.
+func (in *ProxyTopology) DeepCopy() *ProxyTopology {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyTopology)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RateLimit) DeepCopyInto(out *RateLimit) {
 	*out = *in
@@ -614,6 +1062,11 @@ func (in *RateLimit) DeepCopyInto(out *RateLimit) {
 		*out = new(GlobalRateLimit)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Local != nil {
+		in, out := &in.Local, &out.Local
+		*out = new(LocalRateLimit)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimit.
@@ -640,6 +1093,28 @@ func (in *RateLimitRule) DeepCopyInto(out *RateLimitRule) {
 			}
 		}
 	}
+	if in.QueryParamMatches != nil {
+		in, out := &in.QueryParamMatches, &out.QueryParamMatches
+		*out = make([]*StringMatch, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(StringMatch)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.JWTClaimMatches != nil {
+		in, out := &in.JWTClaimMatches, &out.JWTClaimMatches
+		*out = make([]*StringMatch, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(StringMatch)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.CIDRMatch != nil {
 		in, out := &in.CIDRMatch, &out.CIDRMatch
 		*out = new(CIDRMatch)
@@ -717,6 +1192,21 @@ func (in *Redirect) DeepCopy() *Redirect {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegexMatchReplace) DeepCopyInto(out *RegexMatchReplace) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegexMatchReplace.
+func (in *RegexMatchReplace) DeepCopy() *RegexMatchReplace {
+	if in == nil {
+		return nil
+	}
+	out := new(RegexMatchReplace)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RequestAuthentication) DeepCopyInto(out *RequestAuthentication) {
 	*out = *in
@@ -763,6 +1253,53 @@ func (in *RouteDestination) DeepCopy() *RouteDestination {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StatusCodeRange) DeepCopyInto(out *StatusCodeRange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StatusCodeRange.
+func (in *StatusCodeRange) DeepCopy() *StatusCodeRange {
+	if in == nil {
+		return nil
+	}
+	out := new(StatusCodeRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StreamAccessLog) DeepCopyInto(out *StreamAccessLog) {
+	*out = *in
+	if in.Text != nil {
+		in, out := &in.Text, &out.Text
+		*out = new(string)
+		**out = **in
+	}
+	if in.JSON != nil {
+		in, out := &in.JSON, &out.JSON
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(AccessLogFilter)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StreamAccessLog.
+func (in *StreamAccessLog) DeepCopy() *StreamAccessLog {
+	if in == nil {
+		return nil
+	}
+	out := new(StreamAccessLog)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StringMatch) DeepCopyInto(out *StringMatch) {
 	*out = *in
@@ -811,6 +1348,27 @@ func (in *TCPListener) DeepCopyInto(out *TCPListener) {
 		*out = new(RouteDestination)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.WeightedDestinations != nil {
+		in, out := &in.WeightedDestinations, &out.WeightedDestinations
+		*out = make([]*WeightedRouteDestination, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(WeightedRouteDestination)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.ProxySettings != nil {
+		in, out := &in.ProxySettings, &out.ProxySettings
+		*out = new(TCPProxySettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AccessLog != nil {
+		in, out := &in.AccessLog, &out.AccessLog
+		*out = new(AccessLog)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TCPListener.
@@ -823,6 +1381,97 @@ func (in *TCPListener) DeepCopy() *TCPListener {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TCPProxySettings) DeepCopyInto(out *TCPProxySettings) {
+	*out = *in
+	if in.IdleTimeout != nil {
+		in, out := &in.IdleTimeout, &out.IdleTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxConnectionDuration != nil {
+		in, out := &in.MaxConnectionDuration, &out.MaxConnectionDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxConnectAttempts != nil {
+		in, out := &in.MaxConnectAttempts, &out.MaxConnectAttempts
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.AccessLogFlushInterval != nil {
+		in, out := &in.AccessLogFlushInterval, &out.AccessLogFlushInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(TCPRateLimit)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TCPProxySettings.
+func (in *TCPProxySettings) DeepCopy() *TCPProxySettings {
+	if in == nil {
+		return nil
+	}
+	out := new(TCPProxySettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TCPRateLimit) DeepCopyInto(out *TCPRateLimit) {
+	*out = *in
+	if in.Global != nil {
+		in, out := &in.Global, &out.Global
+		*out = make([]*TCPRateLimitRule, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(TCPRateLimitRule)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Local != nil {
+		in, out := &in.Local, &out.Local
+		*out = new(RateLimitValue)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TCPRateLimit.
+func (in *TCPRateLimit) DeepCopy() *TCPRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(TCPRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TCPRateLimitRule) DeepCopyInto(out *TCPRateLimitRule) {
+	*out = *in
+	if in.Limit != nil {
+		in, out := &in.Limit, &out.Limit
+		*out = new(RateLimitValue)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TCPRateLimitRule.
+func (in *TCPRateLimitRule) DeepCopy() *TCPRateLimitRule {
+	if in == nil {
+		return nil
+	}
+	out := new(TCPRateLimitRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TLS) DeepCopyInto(out *TLS) {
 	*out = *in
@@ -907,6 +1556,11 @@ func (in *TextAccessLog) DeepCopyInto(out *TextAccessLog) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(AccessLogFilter)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TextAccessLog.
@@ -943,6 +1597,27 @@ func (in *UDPListener) DeepCopyInto(out *UDPListener) {
 		*out = new(RouteDestination)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.WeightedDestinations != nil {
+		in, out := &in.WeightedDestinations, &out.WeightedDestinations
+		*out = make([]*WeightedRouteDestination, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(WeightedRouteDestination)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.ProxySettings != nil {
+		in, out := &in.ProxySettings, &out.ProxySettings
+		*out = new(UDPProxySettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AccessLog != nil {
+		in, out := &in.AccessLog, &out.AccessLog
+		*out = new(AccessLog)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UDPListener.
@@ -955,6 +1630,26 @@ func (in *UDPListener) DeepCopy() *UDPListener {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UDPProxySettings) DeepCopyInto(out *UDPProxySettings) {
+	*out = *in
+	if in.SessionIdleTimeout != nil {
+		in, out := &in.SessionIdleTimeout, &out.SessionIdleTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UDPProxySettings.
+func (in *UDPProxySettings) DeepCopy() *UDPProxySettings {
+	if in == nil {
+		return nil
+	}
+	out := new(UDPProxySettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *URLRewrite) DeepCopyInto(out *URLRewrite) {
 	*out = *in
@@ -968,6 +1663,11 @@ func (in *URLRewrite) DeepCopyInto(out *URLRewrite) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.HostnameFromHeader != nil {
+		in, out := &in.HostnameFromHeader, &out.HostnameFromHeader
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new URLRewrite.
@@ -1000,13 +1700,28 @@ func (in *UnstructuredRef) DeepCopy() *UnstructuredRef {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Xds) DeepCopyInto(out *Xds) {
+func (in *WeightedRouteDestination) DeepCopyInto(out *WeightedRouteDestination) {
 	*out = *in
-	if in.AccessLog != nil {
-		in, out := &in.AccessLog, &out.AccessLog
-		*out = new(AccessLog)
+	if in.Destination != nil {
+		in, out := &in.Destination, &out.Destination
+		*out = new(RouteDestination)
 		(*in).DeepCopyInto(*out)
 	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WeightedRouteDestination.
+func (in *WeightedRouteDestination) DeepCopy() *WeightedRouteDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(WeightedRouteDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Xds) DeepCopyInto(out *Xds) {
+	*out = *in
 	if in.Tracing != nil {
 		in, out := &in.Tracing, &out.Tracing
 		*out = new(Tracing)
@@ -1017,6 +1732,11 @@ func (in *Xds) DeepCopyInto(out *Xds) {
 		*out = new(Metrics)
 		**out = **in
 	}
+	if in.ConnectionLimit != nil {
+		in, out := &in.ConnectionLimit, &out.ConnectionLimit
+		*out = new(ConnectionLimit)
+		**out = **in
+	}
 	if in.HTTP != nil {
 		in, out := &in.HTTP, &out.HTTP
 		*out = make([]*HTTPListener, len(*in))
@@ -1061,6 +1781,11 @@ func (in *Xds) DeepCopyInto(out *Xds) {
 			}
 		}
 	}
+	if in.ProxyTopology != nil {
+		in, out := &in.ProxyTopology, &out.ProxyTopology
+		*out = new(ProxyTopology)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Xds.
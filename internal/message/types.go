@@ -29,6 +29,12 @@ type ProviderResources struct {
 	TLSRouteStatuses  watchable.Map[types.NamespacedName, *gwapiv1a2.TLSRouteStatus]
 	TCPRouteStatuses  watchable.Map[types.NamespacedName, *gwapiv1a2.TCPRouteStatus]
 	UDPRouteStatuses  watchable.Map[types.NamespacedName, *gwapiv1a2.UDPRouteStatus]
+
+	LocalReplyPolicyStatuses watchable.Map[types.NamespacedName, *egv1a1.LocalReplyPolicyStatus]
+	L4TrafficPolicyStatuses  watchable.Map[types.NamespacedName, *egv1a1.L4TrafficPolicyStatus]
+
+	GRPCJSONTranscoderPolicyStatuses watchable.Map[types.NamespacedName, *egv1a1.GRPCJSONTranscoderPolicyStatus]
+	AccessLogPolicyStatuses          watchable.Map[types.NamespacedName, *egv1a1.AccessLogPolicyStatus]
 }
 
 func (p *ProviderResources) GetResources() *gatewayapi.Resources {
@@ -59,6 +65,10 @@ func (p *ProviderResources) Close() {
 	p.TLSRouteStatuses.Close()
 	p.TCPRouteStatuses.Close()
 	p.UDPRouteStatuses.Close()
+	p.LocalReplyPolicyStatuses.Close()
+	p.L4TrafficPolicyStatuses.Close()
+	p.GRPCJSONTranscoderPolicyStatuses.Close()
+	p.AccessLogPolicyStatuses.Close()
 }
 
 // EnvoyPatchPolicyStatuses message
@@ -49,6 +49,8 @@ const (
 	gatewayTCPRouteIndex          = "gatewayTCPRouteIndex"
 	gatewayUDPRouteIndex          = "gatewayUDPRouteIndex"
 	secretGatewayIndex            = "secretGatewayIndex"
+	configMapLocalReplyIndex      = "configMapLocalReplyIndex"
+	descriptorSetTranscoderIndex  = "descriptorSetTranscoderIndex"
 	targetRefGrantRouteIndex      = "targetRefGrantRouteIndex"
 	backendHTTPRouteIndex         = "backendHTTPRouteIndex"
 	backendGRPCRouteIndex         = "backendGRPCRouteIndex"
@@ -57,6 +59,7 @@ const (
 	backendUDPRouteIndex          = "backendUDPRouteIndex"
 	authenFilterHTTPRouteIndex    = "authenHTTPRouteIndex"
 	rateLimitFilterHTTPRouteIndex = "rateLimitHTTPRouteIndex"
+	httpRouteFilterHTTPRouteIndex = "httpRouteFilterHTTPRouteIndex"
 	authenFilterGRPCRouteIndex    = "authenGRPCRouteIndex"
 	rateLimitFilterGRPCRouteIndex = "rateLimitGRPCRouteIndex"
 )
@@ -143,6 +146,9 @@ type resourceMappings struct {
 	// rateLimitFilters is a map of RateLimitFilters, where the key is the
 	// namespaced name of the RateLimitFilter.
 	rateLimitFilters map[types.NamespacedName]*egv1a1.RateLimitFilter
+	// httpRouteFilters is a map of HTTPRouteFilters, where the key is the
+	// namespaced name of the HTTPRouteFilter.
+	httpRouteFilters map[types.NamespacedName]*egv1a1.HTTPRouteFilter
 	// extensionRefFilters is a map of filters managed by an extension.
 	// The key is the namespaced name of the filter and the value is the
 	// unstructured form of the resource.
@@ -156,6 +162,7 @@ func newResourceMapping() *resourceMappings {
 		allAssociatedRefGrants:   map[types.NamespacedName]*gwapiv1a2.ReferenceGrant{},
 		authenFilters:            map[types.NamespacedName]*egv1a1.AuthenticationFilter{},
 		rateLimitFilters:         map[types.NamespacedName]*egv1a1.RateLimitFilter{},
+		httpRouteFilters:         map[types.NamespacedName]*egv1a1.HTTPRouteFilter{},
 		extensionRefFilters:      map[types.NamespacedName]unstructured.Unstructured{},
 	}
 }
@@ -250,6 +257,21 @@ func (r *gatewayAPIReconciler) Reconcile(ctx context.Context, _ reconcile.Reques
 					"name", string(backendRef.Name))
 			}
 			endpointSliceLabelKey = mcsapi.LabelServiceName
+
+		case egv1a1.KindBackend:
+			backend := new(egv1a1.Backend)
+			err := r.client.Get(ctx, types.NamespacedName{Namespace: string(*backendRef.Namespace), Name: string(backendRef.Name)}, backend)
+			if err != nil {
+				r.log.Error(err, "failed to get Backend", "namespace", string(*backendRef.Namespace),
+					"name", string(backendRef.Name))
+			} else {
+				resourceMap.allAssociatedNamespaces[backend.Namespace] = struct{}{}
+				resourceTree.Backends = append(resourceTree.Backends, backend)
+				r.log.Info("added Backend to resource tree", "namespace", string(*backendRef.Namespace),
+					"name", string(backendRef.Name))
+			}
+			// The endpoints of a Backend are defined by its spec.
+			continue
 		}
 
 		// Retrieve the EndpointSlices associated with the service
@@ -294,6 +316,26 @@ func (r *gatewayAPIReconciler) Reconcile(ctx context.Context, _ reconcile.Reques
 		}
 	}
 
+	// Add all LocalReplyPolicies and the ConfigMaps they reference
+	if err := r.processLocalReplyPolicies(ctx, resourceTree); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	// Add all L4TrafficPolicies
+	if err := r.processL4TrafficPolicies(ctx, resourceTree); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	// Add all GRPCJSONTranscoderPolicies and the descriptor sets they reference
+	if err := r.processGRPCJSONTranscoderPolicies(ctx, resourceTree); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	// Add all AccessLogPolicies
+	if err := r.processAccessLogPolicies(ctx, resourceTree); err != nil {
+		return reconcile.Result{}, err
+	}
+
 	// For this particular Gateway, and all associated objects, check whether the
 	// namespace exists. Add to the resourceTree.
 	for ns := range resourceMap.allAssociatedNamespaces {
@@ -583,6 +625,26 @@ func (r *gatewayAPIReconciler) processGateways(ctx context.Context, acceptedGC *
 			return err
 		}
 
+		// Get the EndpointSlices of the Envoy proxies of the Gateway, used for zone aware routing.
+		endpointSliceList := new(discoveryv1.EndpointSliceList)
+		if err := r.client.List(ctx, endpointSliceList,
+			client.MatchingLabels(map[string]string{
+				gatewayapi.OwningGatewayNamespaceLabel: gtw.Namespace,
+				gatewayapi.OwningGatewayNameLabel:      gtw.Name,
+			}),
+			client.InNamespace(r.namespace),
+		); err != nil {
+			r.log.Error(err, "failed to get EndpointSlices of the Envoy proxies", "namespace", gtw.Namespace,
+				"name", gtw.Name)
+		} else {
+			for _, endpointSlice := range endpointSliceList.Items {
+				endpointSlice := endpointSlice
+				r.log.Info("added EndpointSlice to resource tree", "namespace", endpointSlice.Namespace,
+					"name", endpointSlice.Name)
+				resourceTree.EndpointSlices = append(resourceTree.EndpointSlices, &endpointSlice)
+			}
+		}
+
 		// Discard Status to reduce memory consumption in watchable
 		// It will be recomputed by the gateway-api layer
 		gtw.Status = gwapiv1b1.GatewayStatus{}
@@ -608,9 +670,10 @@ func addReferenceGrantIndexers(ctx context.Context, mgr manager.Manager) error {
 // addHTTPRouteIndexers adds indexing on HTTPRoute.
 //   - For Service, ServiceImports objects that are referenced in HTTPRoute objects via `.spec.rules.backendRefs`.
 //     This helps in querying for HTTPRoutes that are affected by a particular Service CRUD.
-//   - For AuthenticationFilter and RateLimitFilter objects that are referenced in HTTPRoute objects via
-//     `.spec.rules[].filters`. This helps in querying for HTTPRoutes that are affected by a
-//     particular AuthenticationFilter CRUD.
+//   - For AuthenticationFilter, RateLimitFilter and HTTPRouteFilter objects that are referenced in
+//     HTTPRoute objects via `.spec.rules[].filters`, and `.spec.rules[].backendRefs[].filters` for
+//     HTTPRouteFilters. This helps in querying for HTTPRoutes that are
+//     affected by a particular AuthenticationFilter CRUD.
 func addHTTPRouteIndexers(ctx context.Context, mgr manager.Manager) error {
 	if err := mgr.GetFieldIndexer().IndexField(ctx, &gwapiv1b1.HTTPRoute{}, gatewayHTTPRouteIndex, gatewayHTTPRouteIndexFunc); err != nil {
 		return err
@@ -627,6 +690,10 @@ func addHTTPRouteIndexers(ctx context.Context, mgr manager.Manager) error {
 	if err := mgr.GetFieldIndexer().IndexField(ctx, &gwapiv1b1.HTTPRoute{}, rateLimitFilterHTTPRouteIndex, rateLimitFilterHTTPRouteIndexFunc); err != nil {
 		return err
 	}
+
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &gwapiv1b1.HTTPRoute{}, httpRouteFilterHTTPRouteIndex, httpRouteFilterHTTPRouteIndexFunc); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -672,6 +739,34 @@ func rateLimitFilterHTTPRouteIndexFunc(rawObj client.Object) []string {
 	return filters
 }
 
+func httpRouteFilterHTTPRouteIndexFunc(rawObj client.Object) []string {
+	httproute := rawObj.(*gwapiv1b1.HTTPRoute)
+	var filters []string
+	addFilters := func(routeFilters []gwapiv1b1.HTTPRouteFilter) {
+		for i := range routeFilters {
+			filter := routeFilters[i]
+			if gatewayapi.IsHTTPRouteFilter(&filter) {
+				if err := gatewayapi.ValidateHTTPRouteFilter(&filter); err == nil {
+					filters = append(filters,
+						types.NamespacedName{
+							Namespace: httproute.Namespace,
+							Name:      string(filter.ExtensionRef.Name),
+						}.String(),
+					)
+				}
+			}
+		}
+	}
+	for _, rule := range httproute.Spec.Rules {
+		addFilters(rule.Filters)
+		// The HTTPRouteFilters can also configure the backends of the rule.
+		for _, backendRef := range rule.BackendRefs {
+			addFilters(backendRef.Filters)
+		}
+	}
+	return filters
+}
+
 func gatewayHTTPRouteIndexFunc(rawObj client.Object) []string {
 	httproute := rawObj.(*gwapiv1b1.HTTPRoute)
 	var gateways []string
@@ -695,7 +790,7 @@ func backendHTTPRouteIndexFunc(rawObj client.Object) []string {
 	var backendRefs []string
 	for _, rule := range httproute.Spec.Rules {
 		for _, backend := range rule.BackendRefs {
-			if backend.Kind == nil || string(*backend.Kind) == gatewayapi.KindService {
+			if backend.Kind == nil || string(*backend.Kind) == gatewayapi.KindService || string(*backend.Kind) == egv1a1.KindBackend {
 				// If an explicit Backend namespace is not provided, use the HTTPRoute namespace to
 				// lookup the provided Gateway Name.
 				backendRefs = append(backendRefs,
@@ -756,7 +851,7 @@ func backendGRPCRouteIndexFunc(rawObj client.Object) []string {
 	var backendRefs []string
 	for _, rule := range grpcroute.Spec.Rules {
 		for _, backend := range rule.BackendRefs {
-			if backend.Kind == nil || string(*backend.Kind) == gatewayapi.KindService {
+			if backend.Kind == nil || string(*backend.Kind) == gatewayapi.KindService || string(*backend.Kind) == egv1a1.KindBackend {
 				// If an explicit Backend namespace is not provided, use the GRPCRoute namespace to
 				// lookup the provided Gateway Name.
 				backendRefs = append(backendRefs,
@@ -848,7 +943,7 @@ func backendTLSRouteIndexFunc(rawObj client.Object) []string {
 	var backendRefs []string
 	for _, rule := range tlsroute.Spec.Rules {
 		for _, backend := range rule.BackendRefs {
-			if backend.Kind == nil || string(*backend.Kind) == gatewayapi.KindService {
+			if backend.Kind == nil || string(*backend.Kind) == gatewayapi.KindService || string(*backend.Kind) == egv1a1.KindBackend {
 				// If an explicit Backend namespace is not provided, use the TLSRoute namespace to
 				// lookup the provided Gateway Name.
 				backendRefs = append(backendRefs,
@@ -898,7 +993,7 @@ func backendTCPRouteIndexFunc(rawObj client.Object) []string {
 	var backendRefs []string
 	for _, rule := range tcpRoute.Spec.Rules {
 		for _, backend := range rule.BackendRefs {
-			if backend.Kind == nil || string(*backend.Kind) == gatewayapi.KindService {
+			if backend.Kind == nil || string(*backend.Kind) == gatewayapi.KindService || string(*backend.Kind) == egv1a1.KindBackend {
 				// If an explicit Backend namespace is not provided, use the TCPRoute namespace to
 				// lookup the provided Gateway Name.
 				backendRefs = append(backendRefs,
@@ -948,7 +1043,7 @@ func backendUDPRouteIndexFunc(rawObj client.Object) []string {
 	var backendRefs []string
 	for _, rule := range udproute.Spec.Rules {
 		for _, backend := range rule.BackendRefs {
-			if backend.Kind == nil || string(*backend.Kind) == gatewayapi.KindService {
+			if backend.Kind == nil || string(*backend.Kind) == gatewayapi.KindService || string(*backend.Kind) == egv1a1.KindBackend {
 				// If an explicit Backend namespace is not provided, use the UDPRoute namespace to
 				// lookup the provided Gateway Name.
 				backendRefs = append(backendRefs,
@@ -1003,6 +1098,61 @@ func secretGatewayIndexFunc(rawObj client.Object) []string {
 	return secretReferences
 }
 
+// addLocalReplyPolicyIndexers adds indexing on LocalReplyPolicy, for ConfigMap objects
+// that are referenced in LocalReplyPolicy objects. This helps in querying for
+// LocalReplyPolicies that are affected by a particular ConfigMap CRUD.
+func addLocalReplyPolicyIndexers(ctx context.Context, mgr manager.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &egv1a1.LocalReplyPolicy{}, configMapLocalReplyIndex, configMapLocalReplyIndexFunc); err != nil {
+		return err
+	}
+	return nil
+}
+
+func configMapLocalReplyIndexFunc(rawObj client.Object) []string {
+	policy := rawObj.(*egv1a1.LocalReplyPolicy)
+	var configMapReferences []string
+	for _, mapper := range policy.Spec.Mappers {
+		if mapper.Body == nil || mapper.Body.ValueRef == nil {
+			continue
+		}
+		if string(mapper.Body.ValueRef.Kind) == gatewayapi.KindConfigMap {
+			configMapReferences = append(configMapReferences,
+				types.NamespacedName{
+					Namespace: policy.Namespace,
+					Name:      string(mapper.Body.ValueRef.Name),
+				}.String(),
+			)
+		}
+	}
+	return configMapReferences
+}
+
+// addGRPCJSONTranscoderPolicyIndexers adds indexing on GRPCJSONTranscoderPolicy, for the
+// ConfigMap and Secret objects holding the descriptor sets referenced in GRPCJSONTranscoderPolicy
+// objects. This helps in querying for GRPCJSONTranscoderPolicies that are affected by a particular
+// ConfigMap or Secret CRUD.
+func addGRPCJSONTranscoderPolicyIndexers(ctx context.Context, mgr manager.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &egv1a1.GRPCJSONTranscoderPolicy{}, descriptorSetTranscoderIndex, descriptorSetTranscoderIndexFunc); err != nil {
+		return err
+	}
+	return nil
+}
+
+func descriptorSetTranscoderIndexFunc(rawObj client.Object) []string {
+	policy := rawObj.(*egv1a1.GRPCJSONTranscoderPolicy)
+	ref := policy.Spec.DescriptorSetRef
+	if string(ref.Kind) != gatewayapi.KindConfigMap && string(ref.Kind) != gatewayapi.KindSecret {
+		return nil
+	}
+	// ConfigMaps and Secrets share the index, the kind is part of the key.
+	return []string{
+		fmt.Sprintf("%s/%s", ref.Kind, types.NamespacedName{
+			Namespace: policy.Namespace,
+			Name:      string(ref.Name),
+		}.String()),
+	}
+}
+
 // addGcFinalizer adds the gatewayclass or envoyproxy finalizer to the provided object, if it doesn't exist.
 func (r *gatewayAPIReconciler) addFinalizer(ctx context.Context, obj client.Object) error {
 	switch objType := obj.(type) {
@@ -1247,6 +1397,118 @@ func (r *gatewayAPIReconciler) subscribeAndUpdateStatus(ctx context.Context) {
 		)
 		r.log.Info("envoyPatchPolicy status subscriber shutting down")
 	}()
+
+	// LocalReplyPolicy object status updater
+	go func() {
+		message.HandleSubscription(r.resources.LocalReplyPolicyStatuses.Subscribe(ctx),
+			func(update message.Update[types.NamespacedName, *egv1a1.LocalReplyPolicyStatus]) {
+				// skip delete updates.
+				if update.Delete {
+					return
+				}
+				key := update.Key
+				val := update.Value
+				r.statusUpdater.Send(status.Update{
+					NamespacedName: key,
+					Resource:       new(egv1a1.LocalReplyPolicy),
+					Mutator: status.MutatorFunc(func(obj client.Object) client.Object {
+						t, ok := obj.(*egv1a1.LocalReplyPolicy)
+						if !ok {
+							panic(fmt.Sprintf("unsupported object type %T", obj))
+						}
+						tCopy := t.DeepCopy()
+						tCopy.Status = *val
+						return tCopy
+					}),
+				})
+			},
+		)
+		r.log.Info("localReplyPolicy status subscriber shutting down")
+	}()
+
+	// L4TrafficPolicy object status updater
+	go func() {
+		message.HandleSubscription(r.resources.L4TrafficPolicyStatuses.Subscribe(ctx),
+			func(update message.Update[types.NamespacedName, *egv1a1.L4TrafficPolicyStatus]) {
+				// skip delete updates.
+				if update.Delete {
+					return
+				}
+				key := update.Key
+				val := update.Value
+				r.statusUpdater.Send(status.Update{
+					NamespacedName: key,
+					Resource:       new(egv1a1.L4TrafficPolicy),
+					Mutator: status.MutatorFunc(func(obj client.Object) client.Object {
+						t, ok := obj.(*egv1a1.L4TrafficPolicy)
+						if !ok {
+							panic(fmt.Sprintf("unsupported object type %T", obj))
+						}
+						tCopy := t.DeepCopy()
+						tCopy.Status = *val
+						return tCopy
+					}),
+				})
+			},
+		)
+		r.log.Info("l4TrafficPolicy status subscriber shutting down")
+	}()
+
+	// GRPCJSONTranscoderPolicy object status updater
+	go func() {
+		message.HandleSubscription(r.resources.GRPCJSONTranscoderPolicyStatuses.Subscribe(ctx),
+			func(update message.Update[types.NamespacedName, *egv1a1.GRPCJSONTranscoderPolicyStatus]) {
+				// skip delete updates.
+				if update.Delete {
+					return
+				}
+				key := update.Key
+				val := update.Value
+				r.statusUpdater.Send(status.Update{
+					NamespacedName: key,
+					Resource:       new(egv1a1.GRPCJSONTranscoderPolicy),
+					Mutator: status.MutatorFunc(func(obj client.Object) client.Object {
+						t, ok := obj.(*egv1a1.GRPCJSONTranscoderPolicy)
+						if !ok {
+							panic(fmt.Sprintf("unsupported object type %T", obj))
+						}
+						tCopy := t.DeepCopy()
+						tCopy.Status = *val
+						return tCopy
+					}),
+				})
+			},
+		)
+		r.log.Info("grpcJSONTranscoderPolicy status subscriber shutting down")
+	}()
+
+	// AccessLogPolicy object status updater
+	go func() {
+		message.HandleSubscription(r.resources.AccessLogPolicyStatuses.Subscribe(ctx),
+			func(update message.Update[types.NamespacedName, *egv1a1.AccessLogPolicyStatus]) {
+				// skip delete updates.
+				if update.Delete {
+					return
+				}
+				key := update.Key
+				val := update.Value
+				r.statusUpdater.Send(status.Update{
+					NamespacedName: key,
+					Resource:       new(egv1a1.AccessLogPolicy),
+					Mutator: status.MutatorFunc(func(obj client.Object) client.Object {
+						t, ok := obj.(*egv1a1.AccessLogPolicy)
+						if !ok {
+							panic(fmt.Sprintf("unsupported object type %T", obj))
+						}
+						tCopy := t.DeepCopy()
+						tCopy.Status = *val
+						return tCopy
+					}),
+				})
+			},
+		)
+		r.log.Info("accessLogPolicy status subscriber shutting down")
+	}()
 }
 
 // watchResources watches gateway api resources.
@@ -1400,6 +1662,19 @@ func (r *gatewayAPIReconciler) watchResources(ctx context.Context, mgr manager.M
 		}
 	}
 
+	// Watch Backend CRUDs and process affected *Route objects.
+	backendPredicates := []predicate.Predicate{predicate.NewPredicateFuncs(r.validateBackendForReconcile)}
+	if len(r.namespaceLabels) != 0 {
+		backendPredicates = append(backendPredicates, predicate.NewPredicateFuncs(r.hasMatchingNamespaceLabels))
+	}
+	if err := c.Watch(
+		source.Kind(mgr.GetCache(), &egv1a1.Backend{}),
+		handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
+		backendPredicates...,
+	); err != nil {
+		return err
+	}
+
 	// Watch EndpointSlice CRUDs and process affected *Route objects.
 	esPredicates := []predicate.Predicate{predicate.NewPredicateFuncs(r.validateEndpointSliceForReconcile)}
 	if len(r.namespaceLabels) != 0 {
@@ -1496,6 +1771,90 @@ func (r *gatewayAPIReconciler) watchResources(ctx context.Context, mgr manager.M
 		return err
 	}
 
+	hfPredicates := []predicate.Predicate{predicate.NewPredicateFuncs(r.httpRoutesForHTTPRouteFilter)}
+	if len(r.namespaceLabels) != 0 {
+		hfPredicates = append(hfPredicates, predicate.NewPredicateFuncs(r.hasMatchingNamespaceLabels))
+	}
+	// Watch HTTPRouteFilter CRUDs and enqueue associated HTTPRoute objects.
+	if err := c.Watch(
+		source.Kind(mgr.GetCache(), &egv1a1.HTTPRouteFilter{}),
+		handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
+		hfPredicates...,
+	); err != nil {
+		return err
+	}
+
+	// Watch LocalReplyPolicy CRUDs
+	lrpPredicates := []predicate.Predicate{}
+	if len(r.namespaceLabels) != 0 {
+		lrpPredicates = append(lrpPredicates, predicate.NewPredicateFuncs(r.hasMatchingNamespaceLabels))
+	}
+	if err := c.Watch(
+		source.Kind(mgr.GetCache(), &egv1a1.LocalReplyPolicy{}),
+		handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
+		lrpPredicates...,
+	); err != nil {
+		return err
+	}
+	if err := addLocalReplyPolicyIndexers(ctx, mgr); err != nil {
+		return err
+	}
+
+	// Watch L4TrafficPolicy CRUDs
+	ltpPredicates := []predicate.Predicate{}
+	if len(r.namespaceLabels) != 0 {
+		ltpPredicates = append(ltpPredicates, predicate.NewPredicateFuncs(r.hasMatchingNamespaceLabels))
+	}
+	if err := c.Watch(
+		source.Kind(mgr.GetCache(), &egv1a1.L4TrafficPolicy{}),
+		handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
+		ltpPredicates...,
+	); err != nil {
+		return err
+	}
+
+	// Watch GRPCJSONTranscoderPolicy CRUDs
+	gjtpPredicates := []predicate.Predicate{}
+	if len(r.namespaceLabels) != 0 {
+		gjtpPredicates = append(gjtpPredicates, predicate.NewPredicateFuncs(r.hasMatchingNamespaceLabels))
+	}
+	if err := c.Watch(
+		source.Kind(mgr.GetCache(), &egv1a1.GRPCJSONTranscoderPolicy{}),
+		handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
+		gjtpPredicates...,
+	); err != nil {
+		return err
+	}
+	if err := addGRPCJSONTranscoderPolicyIndexers(ctx, mgr); err != nil {
+		return err
+	}
+
+	// Watch AccessLogPolicy CRUDs
+	alpPredicates := []predicate.Predicate{}
+	if len(r.namespaceLabels) != 0 {
+		alpPredicates = append(alpPredicates, predicate.NewPredicateFuncs(r.hasMatchingNamespaceLabels))
+	}
+	if err := c.Watch(
+		source.Kind(mgr.GetCache(), &egv1a1.AccessLogPolicy{}),
+		handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
+		alpPredicates...,
+	); err != nil {
+		return err
+	}
+
+	// Watch ConfigMap CRUDs and process affected LocalReplyPolicies and GRPCJSONTranscoderPolicies.
+	cmPredicates := []predicate.Predicate{predicate.NewPredicateFuncs(r.validateConfigMapForReconcile)}
+	if len(r.namespaceLabels) != 0 {
+		cmPredicates = append(cmPredicates, predicate.NewPredicateFuncs(r.hasMatchingNamespaceLabels))
+	}
+	if err := c.Watch(
+		source.Kind(mgr.GetCache(), &corev1.ConfigMap{}),
+		handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
+		cmPredicates...,
+	); err != nil {
+		return err
+	}
+
 	// Watch EnvoyPatchPolicy if enabled in config
 	eppPredicates := []predicate.Predicate{}
 	if len(r.namespaceLabels) != 0 {
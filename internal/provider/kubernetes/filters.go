@@ -70,6 +70,34 @@ func (r *gatewayAPIReconciler) getRateLimitFilters(ctx context.Context) ([]egv1a
 	return rateLimits, nil
 }
 
+func (r *gatewayAPIReconciler) getHTTPRouteFilters(ctx context.Context) ([]egv1a1.HTTPRouteFilter, error) {
+	httpFilterList := new(egv1a1.HTTPRouteFilterList)
+	if err := r.client.List(ctx, httpFilterList); err != nil {
+		return nil, fmt.Errorf("failed to list HTTPRouteFilters: %v", err)
+	}
+
+	httpFilters := httpFilterList.Items
+	if len(r.namespaceLabels) != 0 {
+		var hfs []egv1a1.HTTPRouteFilter
+		for _, hf := range httpFilters {
+			ns := hf.GetNamespace()
+			ok, err := r.checkObjectNamespaceLabels(ns)
+			if err != nil {
+				// TODO: should return? or just proceed?
+				return nil, fmt.Errorf("failed to check namespace labels for HTTPRouteFilter %s in namespace %s: %s", hf.GetName(), ns, err)
+			}
+
+			if ok {
+				hfs = append(hfs, hf)
+			}
+		}
+
+		httpFilters = hfs
+	}
+
+	return httpFilters, nil
+}
+
 func (r *gatewayAPIReconciler) getExtensionRefFilters(ctx context.Context) ([]unstructured.Unstructured, error) {
 	var resourceItems []unstructured.Unstructured
 	for _, gvk := range r.extGVKs {
@@ -17,6 +17,7 @@ import (
 	mcsapi "sigs.k8s.io/mcs-api/pkg/apis/v1alpha1"
 
 	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
 	"github.com/envoyproxy/gateway/internal/envoygateway/config"
 	"github.com/envoyproxy/gateway/internal/gatewayapi"
 	"github.com/envoyproxy/gateway/internal/provider/utils"
@@ -207,20 +208,24 @@ func infraDeploymentName(gateway *gwapiv1b1.Gateway) string {
 	return fmt.Sprintf("%s-%s", config.EnvoyPrefix, infraName)
 }
 
-// validateBackendRef validates that ref is a reference to a local Service.
+// validateBackendRef validates that ref is a reference to a local Service,
+// ServiceImport or Backend.
 // TODO: Add support for:
 //   - Validating weights.
 //   - Validating ports.
 //   - Referencing HTTPRoutes.
 func validateBackendRef(ref *gwapiv1b1.BackendRef) error {
-	switch {
-	case ref == nil:
+	if ref == nil {
 		return nil
-	case gatewayapi.GroupDerefOr(ref.Group, corev1.GroupName) != corev1.GroupName && gatewayapi.GroupDerefOr(ref.Group, corev1.GroupName) != mcsapi.GroupName:
-		return fmt.Errorf("invalid group; must be nil, empty string or %q", mcsapi.GroupName)
-	case gatewayapi.KindDerefOr(ref.Kind, gatewayapi.KindService) != gatewayapi.KindService && gatewayapi.KindDerefOr(ref.Kind, gatewayapi.KindService) != gatewayapi.KindServiceImport:
-		return fmt.Errorf("invalid kind %q; must be %q or %q",
-			*ref.BackendObjectReference.Kind, gatewayapi.KindService, gatewayapi.KindServiceImport)
+	}
+	group := gatewayapi.GroupDerefOr(ref.Group, corev1.GroupName)
+	kind := gatewayapi.KindDerefOr(ref.Kind, gatewayapi.KindService)
+	switch {
+	case group != corev1.GroupName && group != mcsapi.GroupName && group != egv1a1.GroupVersion.Group:
+		return fmt.Errorf("invalid group; must be nil, empty string, %q or %q", mcsapi.GroupName, egv1a1.GroupVersion.Group)
+	case kind != gatewayapi.KindService && kind != gatewayapi.KindServiceImport && kind != egv1a1.KindBackend:
+		return fmt.Errorf("invalid kind %q; must be %q, %q or %q",
+			*ref.BackendObjectReference.Kind, gatewayapi.KindService, gatewayapi.KindServiceImport, egv1a1.KindBackend)
 	}
 
 	return nil
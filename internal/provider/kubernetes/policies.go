@@ -0,0 +1,196 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/gatewayapi"
+)
+
+// processLocalReplyPolicies adds the LocalReplyPolicies, and the ConfigMaps
+// they reference, to the resourceTree.
+func (r *gatewayAPIReconciler) processLocalReplyPolicies(ctx context.Context, resourceTree *gatewayapi.Resources) error {
+	localReplyPolicies := egv1a1.LocalReplyPolicyList{}
+	if err := r.client.List(ctx, &localReplyPolicies); err != nil {
+		return fmt.Errorf("error listing localreplypolicies: %w", err)
+	}
+
+	configMaps := map[types.NamespacedName]struct{}{}
+	for _, policy := range localReplyPolicies.Items {
+		policy := policy
+		if len(r.namespaceLabels) != 0 {
+			ok, err := r.checkObjectNamespaceLabels(policy.Namespace)
+			if err != nil {
+				return fmt.Errorf("failed to check namespace labels for LocalReplyPolicy %s in namespace %s: %w",
+					policy.Name, policy.Namespace, err)
+			}
+			if !ok {
+				continue
+			}
+		}
+
+		// Discard Status to reduce memory consumption in watchable
+		// It will be recomputed by the gateway-api layer
+		policy.Status = egv1a1.LocalReplyPolicyStatus{}
+		resourceTree.LocalReplyPolicies = append(resourceTree.LocalReplyPolicies, &policy)
+
+		for _, mapper := range policy.Spec.Mappers {
+			if mapper.Body == nil || mapper.Body.ValueRef == nil ||
+				string(mapper.Body.ValueRef.Kind) != gatewayapi.KindConfigMap {
+				continue
+			}
+			key := types.NamespacedName{Namespace: policy.Namespace, Name: string(mapper.Body.ValueRef.Name)}
+			if _, ok := configMaps[key]; ok {
+				continue
+			}
+			configMaps[key] = struct{}{}
+
+			configMap := new(corev1.ConfigMap)
+			if err := r.client.Get(ctx, key, configMap); err != nil {
+				if kerrors.IsNotFound(err) {
+					r.log.Info("ConfigMap referenced by LocalReplyPolicy not found", "namespace", key.Namespace,
+						"name", key.Name)
+					continue
+				}
+				return fmt.Errorf("failed to get ConfigMap %s: %w", key, err)
+			}
+			resourceTree.ConfigMaps = append(resourceTree.ConfigMaps, configMap)
+			r.log.Info("added ConfigMap to resource tree", "namespace", key.Namespace, "name", key.Name)
+		}
+	}
+
+	return nil
+}
+
+// processL4TrafficPolicies adds the L4TrafficPolicies to the resourceTree.
+func (r *gatewayAPIReconciler) processL4TrafficPolicies(ctx context.Context, resourceTree *gatewayapi.Resources) error {
+	l4TrafficPolicies := egv1a1.L4TrafficPolicyList{}
+	if err := r.client.List(ctx, &l4TrafficPolicies); err != nil {
+		return fmt.Errorf("error listing l4trafficpolicies: %w", err)
+	}
+
+	for _, policy := range l4TrafficPolicies.Items {
+		policy := policy
+		if len(r.namespaceLabels) != 0 {
+			ok, err := r.checkObjectNamespaceLabels(policy.Namespace)
+			if err != nil {
+				return fmt.Errorf("failed to check namespace labels for L4TrafficPolicy %s in namespace %s: %w",
+					policy.Name, policy.Namespace, err)
+			}
+			if !ok {
+				continue
+			}
+		}
+
+		// Discard Status to reduce memory consumption in watchable
+		// It will be recomputed by the gateway-api layer
+		policy.Status = egv1a1.L4TrafficPolicyStatus{}
+		resourceTree.L4TrafficPolicies = append(resourceTree.L4TrafficPolicies, &policy)
+	}
+
+	return nil
+}
+
+// processGRPCJSONTranscoderPolicies adds the GRPCJSONTranscoderPolicies, and the
+// ConfigMaps and Secrets holding their descriptor sets, to the resourceTree.
+func (r *gatewayAPIReconciler) processGRPCJSONTranscoderPolicies(ctx context.Context, resourceTree *gatewayapi.Resources) error {
+	grpcJSONTranscoderPolicies := egv1a1.GRPCJSONTranscoderPolicyList{}
+	if err := r.client.List(ctx, &grpcJSONTranscoderPolicies); err != nil {
+		return fmt.Errorf("error listing grpcjsontranscoderpolicies: %w", err)
+	}
+
+	for _, policy := range grpcJSONTranscoderPolicies.Items {
+		policy := policy
+		if len(r.namespaceLabels) != 0 {
+			ok, err := r.checkObjectNamespaceLabels(policy.Namespace)
+			if err != nil {
+				return fmt.Errorf("failed to check namespace labels for GRPCJSONTranscoderPolicy %s in namespace %s: %w",
+					policy.Name, policy.Namespace, err)
+			}
+			if !ok {
+				continue
+			}
+		}
+
+		// Discard Status to reduce memory consumption in watchable
+		// It will be recomputed by the gateway-api layer
+		policy.Status = egv1a1.GRPCJSONTranscoderPolicyStatus{}
+		resourceTree.GRPCJSONTranscoderPolicies = append(resourceTree.GRPCJSONTranscoderPolicies, &policy)
+
+		ref := policy.Spec.DescriptorSetRef
+		key := types.NamespacedName{Namespace: policy.Namespace, Name: string(ref.Name)}
+		switch string(ref.Kind) {
+		case gatewayapi.KindConfigMap:
+			if resourceTree.GetConfigMap(key.Namespace, key.Name) != nil {
+				continue
+			}
+			configMap := new(corev1.ConfigMap)
+			if err := r.client.Get(ctx, key, configMap); err != nil {
+				if kerrors.IsNotFound(err) {
+					r.log.Info("ConfigMap referenced by GRPCJSONTranscoderPolicy not found", "namespace", key.Namespace,
+						"name", key.Name)
+					continue
+				}
+				return fmt.Errorf("failed to get ConfigMap %s: %w", key, err)
+			}
+			resourceTree.ConfigMaps = append(resourceTree.ConfigMaps, configMap)
+			r.log.Info("added ConfigMap to resource tree", "namespace", key.Namespace, "name", key.Name)
+		case gatewayapi.KindSecret:
+			if resourceTree.GetSecret(key.Namespace, key.Name) != nil {
+				continue
+			}
+			secret := new(corev1.Secret)
+			if err := r.client.Get(ctx, key, secret); err != nil {
+				if kerrors.IsNotFound(err) {
+					r.log.Info("Secret referenced by GRPCJSONTranscoderPolicy not found", "namespace", key.Namespace,
+						"name", key.Name)
+					continue
+				}
+				return fmt.Errorf("failed to get Secret %s: %w", key, err)
+			}
+			resourceTree.Secrets = append(resourceTree.Secrets, secret)
+			r.log.Info("added Secret to resource tree", "namespace", key.Namespace, "name", key.Name)
+		}
+	}
+
+	return nil
+}
+
+// processAccessLogPolicies adds the AccessLogPolicies to the resourceTree.
+func (r *gatewayAPIReconciler) processAccessLogPolicies(ctx context.Context, resourceTree *gatewayapi.Resources) error {
+	accessLogPolicies := egv1a1.AccessLogPolicyList{}
+	if err := r.client.List(ctx, &accessLogPolicies); err != nil {
+		return fmt.Errorf("error listing accesslogpolicies: %w", err)
+	}
+
+	for _, policy := range accessLogPolicies.Items {
+		policy := policy
+		if len(r.namespaceLabels) != 0 {
+			ok, err := r.checkObjectNamespaceLabels(policy.Namespace)
+			if err != nil {
+				return fmt.Errorf("failed to check namespace labels for AccessLogPolicy %s in namespace %s: %w",
+					policy.Name, policy.Namespace, err)
+			}
+			if !ok {
+				continue
+			}
+		}
+
+		// Discard Status to reduce memory consumption in watchable
+		// It will be recomputed by the gateway-api layer
+		policy.Status = egv1a1.AccessLogPolicyStatus{}
+		resourceTree.AccessLogPolicies = append(resourceTree.AccessLogPolicies, &policy)
+	}
+
+	return nil
+}
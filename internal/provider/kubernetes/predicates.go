@@ -7,6 +7,7 @@ package kubernetes
 
 import (
 	"context"
+	"fmt"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -136,6 +137,10 @@ func (r *gatewayAPIReconciler) validateSecretForReconcile(obj client.Object) boo
 		return false
 	}
 
+	if r.isDescriptorSetReferenced(gatewayapi.KindSecret, utils.NamespacedName(secret)) {
+		return true
+	}
+
 	gwList := &gwapiv1b1.GatewayList{}
 	if err := r.client.List(context.Background(), gwList, &client.ListOptions{
 		FieldSelector: fields.OneTermEqualSelector(secretGatewayIndex, utils.NamespacedName(secret).String()),
@@ -158,6 +163,44 @@ func (r *gatewayAPIReconciler) validateSecretForReconcile(obj client.Object) boo
 	return true
 }
 
+// validateConfigMapForReconcile checks whether the ConfigMap is referenced by a LocalReplyPolicy
+// or a GRPCJSONTranscoderPolicy.
+func (r *gatewayAPIReconciler) validateConfigMapForReconcile(obj client.Object) bool {
+	configMap, ok := obj.(*corev1.ConfigMap)
+	if !ok {
+		r.log.Info("unexpected object type, bypassing reconciliation", "object", obj)
+		return false
+	}
+
+	if r.isDescriptorSetReferenced(gatewayapi.KindConfigMap, utils.NamespacedName(configMap)) {
+		return true
+	}
+
+	policyList := &egv1a1.LocalReplyPolicyList{}
+	if err := r.client.List(context.Background(), policyList, &client.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector(configMapLocalReplyIndex, utils.NamespacedName(configMap).String()),
+	}); err != nil {
+		r.log.Error(err, "unable to find associated LocalReplyPolicies")
+		return false
+	}
+
+	return len(policyList.Items) != 0
+}
+
+// isDescriptorSetReferenced checks whether the ConfigMap or Secret is referenced
+// by a GRPCJSONTranscoderPolicy as its descriptor set.
+func (r *gatewayAPIReconciler) isDescriptorSetReferenced(kind string, nsName types.NamespacedName) bool {
+	policyList := &egv1a1.GRPCJSONTranscoderPolicyList{}
+	if err := r.client.List(context.Background(), policyList, &client.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector(descriptorSetTranscoderIndex, fmt.Sprintf("%s/%s", kind, nsName.String())),
+	}); err != nil {
+		r.log.Error(err, "unable to find associated GRPCJSONTranscoderPolicies")
+		return false
+	}
+
+	return len(policyList.Items) != 0
+}
+
 // validateServiceForReconcile tries finding the owning Gateway of the Service
 // if it exists, finds the Gateway's Deployment, and further updates the Gateway
 // status Ready condition. All Services are pushed for reconciliation.
@@ -194,7 +237,19 @@ func (r *gatewayAPIReconciler) validateServiceImportForReconcile(obj client.Obje
 	return r.isRouteReferencingBackend(&nsName)
 }
 
-// isRouteReferencingBackend returns true if the backend(service and serviceImport) is referenced by any of the xRoutes
+// validateBackendForReconcile returns true if the Backend is referenced by any of the xRoutes.
+func (r *gatewayAPIReconciler) validateBackendForReconcile(obj client.Object) bool {
+	backend, ok := obj.(*egv1a1.Backend)
+	if !ok {
+		r.log.Info("unexpected object type, bypassing reconciliation", "object", obj)
+		return false
+	}
+
+	nsName := utils.NamespacedName(backend)
+	return r.isRouteReferencingBackend(&nsName)
+}
+
+// isRouteReferencingBackend returns true if the backend(service, serviceImport and Backend) is referenced by any of the xRoutes
 // in the system, else returns false.
 func (r *gatewayAPIReconciler) isRouteReferencingBackend(nsName *types.NamespacedName) bool {
 	ctx := context.Background()
@@ -249,7 +304,7 @@ func (r *gatewayAPIReconciler) isRouteReferencingBackend(nsName *types.Namespace
 }
 
 // validateEndpointSliceForReconcile returns true if the the endpointSlice references
-// a service that is referenced by a xRoute
+// a service that is referenced by a xRoute, or holds the Envoy proxies of a Gateway.
 func (r *gatewayAPIReconciler) validateEndpointSliceForReconcile(obj client.Object) bool {
 	ep, ok := obj.(*discoveryv1.EndpointSlice)
 	if !ok {
@@ -257,6 +312,11 @@ func (r *gatewayAPIReconciler) validateEndpointSliceForReconcile(obj client.Obje
 		return false
 	}
 
+	// The zones of the Envoy proxies are used for zone aware routing.
+	if ep.Namespace == r.namespace && r.findOwningGateway(context.Background(), ep.GetLabels()) != nil {
+		return true
+	}
+
 	svcName, ok := ep.GetLabels()[discoveryv1.LabelServiceName]
 	multiClusterSvcName, isMCS := ep.GetLabels()[mcsapi.LabelServiceName]
 	if !ok && !isMCS {
@@ -349,6 +409,30 @@ func (r *gatewayAPIReconciler) httpRoutesForRateLimitFilter(obj client.Object) b
 	return len(httpRoutes) != 0
 }
 
+// httpRoutesForHTTPRouteFilter tries finding HTTPRoute referents of the provided
+// HTTPRouteFilter and returns true if any exist.
+func (r *gatewayAPIReconciler) httpRoutesForHTTPRouteFilter(obj client.Object) bool {
+	ctx := context.Background()
+	filter, ok := obj.(*egv1a1.HTTPRouteFilter)
+	if !ok {
+		r.log.Info("unexpected object type, bypassing reconciliation", "object", obj)
+		return false
+	}
+
+	// Check if the HTTPRouteFilter belongs to a managed HTTPRoute.
+	httpRouteList := &gwapiv1b1.HTTPRouteList{}
+	if err := r.client.List(ctx, httpRouteList, &client.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector(httpRouteFilterHTTPRouteIndex, utils.NamespacedName(filter).String()),
+	}); err != nil {
+		r.log.Error(err, "unable to find associated HTTPRoutes")
+		return false
+	}
+
+	httpRoutes := r.filterHTTPRoutesByNamespaceLabels(httpRouteList.Items)
+
+	return len(httpRoutes) != 0
+}
+
 func (r *gatewayAPIReconciler) filterHTTPRoutesByNamespaceLabels(httpRoutes []gwapiv1b1.HTTPRoute) []gwapiv1b1.HTTPRoute {
 	if len(r.namespaceLabels) == 0 {
 		return httpRoutes
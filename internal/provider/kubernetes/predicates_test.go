@@ -278,6 +278,20 @@ func TestValidateEndpointSliceForReconcile(t *testing.T) {
 			endpointSlice: test.GetEndpointSlice(types.NamespacedName{Name: "endpointslice"}, "service"),
 			expect:        true,
 		},
+		{
+			name: "envoy proxy endpointslice of a gateway",
+			configs: []client.Object{
+				test.GetGatewayClass("test-gc", v1alpha1.GatewayControllerName),
+				sampleGateway,
+			},
+			endpointSlice: func() client.Object {
+				endpointSlice := test.GetEndpointSlice(types.NamespacedName{Namespace: "envoy-gateway-system", Name: "envoy-endpointslice"}, "envoy-service")
+				endpointSlice.Labels[gatewayapi.OwningGatewayNamespaceLabel] = "default"
+				endpointSlice.Labels[gatewayapi.OwningGatewayNameLabel] = "scheduled-status-test"
+				return endpointSlice
+			}(),
+			expect: true,
+		},
 	}
 
 	// Create the reconciler.
@@ -286,6 +300,7 @@ func TestValidateEndpointSliceForReconcile(t *testing.T) {
 	r := gatewayAPIReconciler{
 		classController: v1alpha1.GatewayControllerName,
 		log:             logger,
+		namespace:       "envoy-gateway-system",
 	}
 
 	for _, tc := range testCases {
@@ -165,6 +165,22 @@ func (r *gatewayAPIReconciler) processGRPCRoutes(ctx context.Context, gatewayNam
 					continue
 				}
 
+				// Load in the HTTPRouteFilters referenced by the filters of the backendRef.
+				// NOTE: filters must be in the same namespace as the GRPCRoute
+				for _, filter := range backendRef.Filters {
+					if filter.Type != gwapiv1a2.GRPCRouteFilterExtensionRef || filter.ExtensionRef == nil ||
+						string(filter.ExtensionRef.Kind) != egv1a1.KindHTTPRouteFilter {
+						continue
+					}
+					key := types.NamespacedName{
+						Namespace: grpcRoute.Namespace,
+						Name:      string(filter.ExtensionRef.Name),
+					}
+					if httpRouteFilter, ok := resourceMap.httpRouteFilters[key]; ok {
+						resourceTree.HTTPRouteFilters = append(resourceTree.HTTPRouteFilters, httpRouteFilter)
+					}
+				}
+
 				backendNamespace := gatewayapi.NamespaceDerefOr(backendRef.Namespace, grpcRoute.Namespace)
 				resourceMap.allAssociatedBackendRefs[gwapiv1b1.BackendObjectReference{
 					Group:     backendRef.BackendObjectReference.Group,
@@ -236,6 +252,18 @@ func (r *gatewayAPIReconciler) processGRPCRoutes(ctx context.Context, gatewayNam
 						}
 
 						resourceTree.RateLimitFilters = append(resourceTree.RateLimitFilters, rateLimitFilter)
+					case egv1a1.KindHTTPRouteFilter:
+						key := types.NamespacedName{
+							Namespace: grpcRoute.Namespace,
+							Name:      string(filter.ExtensionRef.Name),
+						}
+						httpRouteFilter, ok := resourceMap.httpRouteFilters[key]
+						if !ok {
+							r.log.Error(err, "HTTPRouteFilter not found; bypassing rule", "index", i)
+							continue
+						}
+
+						resourceTree.HTTPRouteFilters = append(resourceTree.HTTPRouteFilters, httpRouteFilter)
 					default:
 						// If the Kind does not match any Envoy Gateway resources, check if it's a Kind
 						// managed by an extension and add to resourceTree
@@ -271,8 +299,8 @@ func (r *gatewayAPIReconciler) processHTTPRoutes(ctx context.Context, gatewayNam
 	resourceMap *resourceMappings, resourceTree *gatewayapi.Resources) error {
 	httpRouteList := &gwapiv1b1.HTTPRouteList{}
 
-	// An HTTPRoute may reference an AuthenticationFilter, RateLimitFilter, or a filter managed
-	// by an extension so add them to the resource map first (if they exist).
+	// An HTTPRoute may reference an AuthenticationFilter, RateLimitFilter, HTTPRouteFilter, or a filter
+	// managed by an extension so add them to the resource map first (if they exist).
 	authenFilters, err := r.getAuthenticationFilters(ctx)
 	if err != nil {
 		return err
@@ -291,6 +319,15 @@ func (r *gatewayAPIReconciler) processHTTPRoutes(ctx context.Context, gatewayNam
 		resourceMap.rateLimitFilters[utils.NamespacedName(&filter)] = &filter
 	}
 
+	httpRouteFilters, err := r.getHTTPRouteFilters(ctx)
+	if err != nil {
+		return err
+	}
+	for i := range httpRouteFilters {
+		filter := httpRouteFilters[i]
+		resourceMap.httpRouteFilters[utils.NamespacedName(&filter)] = &filter
+	}
+
 	extensionRefFilters, err := r.getExtensionRefFilters(ctx)
 	if err != nil {
 		return err
@@ -337,6 +374,22 @@ func (r *gatewayAPIReconciler) processHTTPRoutes(ctx context.Context, gatewayNam
 					continue
 				}
 
+				// Load in the HTTPRouteFilters referenced by the filters of the backendRef.
+				// NOTE: filters must be in the same namespace as the HTTPRoute
+				for _, filter := range backendRef.Filters {
+					if filter.Type != gwapiv1b1.HTTPRouteFilterExtensionRef || filter.ExtensionRef == nil ||
+						string(filter.ExtensionRef.Kind) != egv1a1.KindHTTPRouteFilter {
+						continue
+					}
+					key := types.NamespacedName{
+						Namespace: httpRoute.Namespace,
+						Name:      string(filter.ExtensionRef.Name),
+					}
+					if httpRouteFilter, ok := resourceMap.httpRouteFilters[key]; ok {
+						resourceTree.HTTPRouteFilters = append(resourceTree.HTTPRouteFilters, httpRouteFilter)
+					}
+				}
+
 				backendNamespace := gatewayapi.NamespaceDerefOr(backendRef.Namespace, httpRoute.Namespace)
 				resourceMap.allAssociatedBackendRefs[gwapiv1b1.BackendObjectReference{
 					Group:     backendRef.BackendObjectReference.Group,
@@ -391,51 +444,10 @@ func (r *gatewayAPIReconciler) processHTTPRoutes(ctx context.Context, gatewayNam
 						continue
 					}
 
-					mirrorBackendObj := mirrorFilter.BackendRef
-					// Wrap the filter's BackendObjectReference into a BackendRef so we can use existing tooling to check it
-					weight := int32(1)
-					mirrorBackendRef := gwapiv1b1.BackendRef{
-						BackendObjectReference: mirrorBackendObj,
-						Weight:                 &weight,
-					}
-
-					if err := validateBackendRef(&mirrorBackendRef); err != nil {
+					if err := r.processHTTPRouteMirrorBackendRef(ctx, &httpRoute, mirrorFilter.BackendRef, resourceMap); err != nil {
 						r.log.Error(err, "invalid backendRef")
 						continue
 					}
-
-					backendNamespace := gatewayapi.NamespaceDerefOr(mirrorBackendRef.Namespace, httpRoute.Namespace)
-					resourceMap.allAssociatedBackendRefs[gwapiv1b1.BackendObjectReference{
-						Group:     mirrorBackendRef.BackendObjectReference.Group,
-						Kind:      mirrorBackendRef.BackendObjectReference.Kind,
-						Namespace: gatewayapi.NamespacePtrV1Alpha2(backendNamespace),
-						Name:      mirrorBackendRef.Name,
-					}] = struct{}{}
-
-					if backendNamespace != httpRoute.Namespace {
-						from := ObjectKindNamespacedName{
-							kind:      gatewayapi.KindHTTPRoute,
-							namespace: httpRoute.Namespace,
-							name:      httpRoute.Name,
-						}
-						to := ObjectKindNamespacedName{
-							kind:      gatewayapi.KindDerefOr(mirrorBackendRef.Kind, gatewayapi.KindService),
-							namespace: backendNamespace,
-							name:      string(mirrorBackendRef.Name),
-						}
-						refGrant, err := r.findReferenceGrant(ctx, from, to)
-						switch {
-						case err != nil:
-							r.log.Error(err, "failed to find ReferenceGrant")
-						case refGrant == nil:
-							r.log.Info("no matching ReferenceGrants found", "from", from.kind,
-								"from namespace", from.namespace, "target", to.kind, "target namespace", to.namespace)
-						default:
-							resourceMap.allAssociatedRefGrants[utils.NamespacedName(refGrant)] = refGrant
-							r.log.Info("added ReferenceGrant to resource map", "namespace", refGrant.Namespace,
-								"name", refGrant.Name)
-						}
-					}
 				} else if filter.Type == gwapiv1b1.HTTPRouteFilterExtensionRef {
 					// NOTE: filters must be in the same namespace as the HTTPRoute
 					switch string(filter.ExtensionRef.Kind) {
@@ -463,6 +475,26 @@ func (r *gatewayAPIReconciler) processHTTPRoutes(ctx context.Context, gatewayNam
 						}
 
 						resourceTree.RateLimitFilters = append(resourceTree.RateLimitFilters, rateLimitFilter)
+					case egv1a1.KindHTTPRouteFilter:
+						key := types.NamespacedName{
+							Namespace: httpRoute.Namespace,
+							Name:      string(filter.ExtensionRef.Name),
+						}
+						httpRouteFilter, ok := resourceMap.httpRouteFilters[key]
+						if !ok {
+							r.log.Error(err, "HTTPRouteFilter not found; bypassing rule", "index", i)
+							continue
+						}
+
+						resourceTree.HTTPRouteFilters = append(resourceTree.HTTPRouteFilters, httpRouteFilter)
+
+						// Load in the backendRef of the requestMirror of the HTTPRouteFilter
+						if mirror := httpRouteFilter.Spec.RequestMirror; mirror != nil {
+							if err := r.processHTTPRouteMirrorBackendRef(ctx, &httpRoute, mirror.BackendRef, resourceMap); err != nil {
+								r.log.Error(err, "invalid backendRef")
+								continue
+							}
+						}
 					default:
 						// If the Kind does not match any Envoy Gateway resources, check if it's a Kind
 						// managed by an extension and add to resourceTree
@@ -492,6 +524,57 @@ func (r *gatewayAPIReconciler) processHTTPRoutes(ctx context.Context, gatewayNam
 	return nil
 }
 
+// processHTTPRouteMirrorBackendRef adds the backendRef of a mirror filter of the provided HTTPRoute,
+// as well as the ReferenceGrant allowing it if the backend is in another namespace, to the resourceMap.
+func (r *gatewayAPIReconciler) processHTTPRouteMirrorBackendRef(ctx context.Context, httpRoute *gwapiv1b1.HTTPRoute,
+	mirrorBackendObj gwapiv1b1.BackendObjectReference, resourceMap *resourceMappings) error {
+	// Wrap the filter's BackendObjectReference into a BackendRef so we can use existing tooling to check it
+	weight := int32(1)
+	mirrorBackendRef := gwapiv1b1.BackendRef{
+		BackendObjectReference: mirrorBackendObj,
+		Weight:                 &weight,
+	}
+
+	if err := validateBackendRef(&mirrorBackendRef); err != nil {
+		return err
+	}
+
+	backendNamespace := gatewayapi.NamespaceDerefOr(mirrorBackendRef.Namespace, httpRoute.Namespace)
+	resourceMap.allAssociatedBackendRefs[gwapiv1b1.BackendObjectReference{
+		Group:     mirrorBackendRef.BackendObjectReference.Group,
+		Kind:      mirrorBackendRef.BackendObjectReference.Kind,
+		Namespace: gatewayapi.NamespacePtrV1Alpha2(backendNamespace),
+		Name:      mirrorBackendRef.Name,
+	}] = struct{}{}
+
+	if backendNamespace != httpRoute.Namespace {
+		from := ObjectKindNamespacedName{
+			kind:      gatewayapi.KindHTTPRoute,
+			namespace: httpRoute.Namespace,
+			name:      httpRoute.Name,
+		}
+		to := ObjectKindNamespacedName{
+			kind:      gatewayapi.KindDerefOr(mirrorBackendRef.Kind, gatewayapi.KindService),
+			namespace: backendNamespace,
+			name:      string(mirrorBackendRef.Name),
+		}
+		refGrant, err := r.findReferenceGrant(ctx, from, to)
+		switch {
+		case err != nil:
+			r.log.Error(err, "failed to find ReferenceGrant")
+		case refGrant == nil:
+			r.log.Info("no matching ReferenceGrants found", "from", from.kind,
+				"from namespace", from.namespace, "target", to.kind, "target namespace", to.namespace)
+		default:
+			resourceMap.allAssociatedRefGrants[utils.NamespacedName(refGrant)] = refGrant
+			r.log.Info("added ReferenceGrant to resource map", "namespace", refGrant.Namespace,
+				"name", refGrant.Name)
+		}
+	}
+
+	return nil
+}
+
 // processTCPRoutes finds TCPRoutes corresponding to a gatewayNamespaceName, further checks for
 // the backend references and pushes the TCPRoutes to the resourceTree.
 func (r *gatewayAPIReconciler) processTCPRoutes(ctx context.Context, gatewayNamespaceName string,
@@ -168,6 +168,10 @@ func (u *UpdateWriter) Send(update Update) {
 //	UDPRoute
 //	GRPCRoute
 //	EnvoyPatchPolicy
+//	LocalReplyPolicy
+//	L4TrafficPolicy
+//	GRPCJSONTranscoderPolicy
+//	AccessLogPolicy
 func isStatusEqual(objA, objB interface{}) bool {
 	opts := cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime")
 	switch a := objA.(type) {
@@ -219,6 +223,30 @@ func isStatusEqual(objA, objB interface{}) bool {
 				return true
 			}
 		}
+	case *egv1a1.LocalReplyPolicy:
+		if b, ok := objB.(*egv1a1.LocalReplyPolicy); ok {
+			if cmp.Equal(a.Status, b.Status, opts) {
+				return true
+			}
+		}
+	case *egv1a1.L4TrafficPolicy:
+		if b, ok := objB.(*egv1a1.L4TrafficPolicy); ok {
+			if cmp.Equal(a.Status, b.Status, opts) {
+				return true
+			}
+		}
+	case *egv1a1.GRPCJSONTranscoderPolicy:
+		if b, ok := objB.(*egv1a1.GRPCJSONTranscoderPolicy); ok {
+			if cmp.Equal(a.Status, b.Status, opts) {
+				return true
+			}
+		}
+	case *egv1a1.AccessLogPolicy:
+		if b, ok := objB.(*egv1a1.AccessLogPolicy); ok {
+			if cmp.Equal(a.Status, b.Status, opts) {
+				return true
+			}
+		}
 	}
 	return false
 }
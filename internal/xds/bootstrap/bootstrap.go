@@ -33,6 +33,14 @@ const (
 	// DefaultXdsServerPort is the default listening port of the xds-server.
 	DefaultXdsServerPort = 18000
 
+	// EnvoyLocalClusterName is the name of the cluster of the Envoy proxies of a gateway,
+	// defined in the bootstrap configuration. Its endpoints are sent by the xds-server.
+	EnvoyLocalClusterName = "local_cluster"
+
+	// fixedHeapResourceMonitor is the name of the overload manager resource
+	// monitor of the heap size of Envoy.
+	fixedHeapResourceMonitor = "envoy.resource_monitors.fixed_heap"
+
 	envoyReadinessAddress = "0.0.0.0"
 	EnvoyReadinessPort    = 19001
 	EnvoyReadinessPath    = "/ready"
@@ -68,6 +76,32 @@ type bootstrapParameters struct {
 	// StatsMatcher is to control creation of custom Envoy stats with prefix,
 	// suffix, and regex expressions match on the name of the stats.
 	StatsMatcher *StatsMatcherParameters
+	// OverloadManager defines the configuration of the overload manager.
+	OverloadManager *overloadManagerParameters
+}
+
+type overloadManagerParameters struct {
+	// HeapResourceMonitor is the name of the resource monitor of the heap size.
+	HeapResourceMonitor string
+	// MaxHeapSizeBytes is the maximum heap size of Envoy.
+	MaxHeapSizeBytes int64
+	// Actions are the overload actions triggered by the heap size.
+	Actions []overloadActionParameters
+}
+
+type overloadActionParameters struct {
+	// Name is the name of the overload action.
+	Name string
+	// Threshold is the ratio of the maximum heap size triggering the action.
+	Threshold float64
+}
+
+// overloadActionNames maps the overload action types to the names of the Envoy overload actions.
+var overloadActionNames = map[egcfgv1a1.ProxyOverloadActionType]string{
+	egcfgv1a1.StopAcceptingConnectionsOverloadAction: "envoy.overload_actions.stop_accepting_connections",
+	egcfgv1a1.StopAcceptingRequestsOverloadAction:    "envoy.overload_actions.stop_accepting_requests",
+	egcfgv1a1.DisableHTTPKeepAliveOverloadAction:     "envoy.overload_actions.disable_http_keepalive",
+	egcfgv1a1.ShrinkHeapOverloadAction:               "envoy.overload_actions.shrink_heap",
 }
 
 type xdsServerParameters struct {
@@ -120,7 +154,7 @@ func (b *bootstrapConfig) render() error {
 }
 
 // GetRenderedBootstrapConfig renders the bootstrap YAML string
-func GetRenderedBootstrapConfig(proxyMetrics *egcfgv1a1.ProxyMetrics) (string, error) {
+func GetRenderedBootstrapConfig(proxyMetrics *egcfgv1a1.ProxyMetrics, proxyOverload *egcfgv1a1.ProxyOverload) (string, error) {
 	var (
 		enablePrometheus bool
 		metricSinks      []metricSink
@@ -191,6 +225,7 @@ func GetRenderedBootstrapConfig(proxyMetrics *egcfgv1a1.ProxyMetrics) (string, e
 	if proxyMetrics != nil && proxyMetrics.Matches != nil {
 		cfg.parameters.StatsMatcher = &StatsMatcher
 	}
+	cfg.parameters.OverloadManager = buildOverloadManagerParameters(proxyOverload)
 
 	if err := cfg.render(); err != nil {
 		return "", err
@@ -198,3 +233,28 @@ func GetRenderedBootstrapConfig(proxyMetrics *egcfgv1a1.ProxyMetrics) (string, e
 
 	return cfg.rendered, nil
 }
+
+// buildOverloadManagerParameters returns the parameters of the overload manager,
+// or nil if no overload action is configured.
+func buildOverloadManagerParameters(proxyOverload *egcfgv1a1.ProxyOverload) *overloadManagerParameters {
+	if proxyOverload == nil || proxyOverload.MaxHeapSize == nil || len(proxyOverload.Actions) == 0 {
+		return nil
+	}
+
+	params := &overloadManagerParameters{
+		HeapResourceMonitor: fixedHeapResourceMonitor,
+		MaxHeapSizeBytes:    proxyOverload.MaxHeapSize.Value(),
+	}
+	for _, action := range proxyOverload.Actions {
+		name, ok := overloadActionNames[action.Type]
+		if !ok {
+			continue
+		}
+		params.Actions = append(params.Actions, overloadActionParameters{
+			Name:      name,
+			Threshold: float64(action.HeapThresholdPercent) / 100,
+		})
+	}
+
+	return params
+}
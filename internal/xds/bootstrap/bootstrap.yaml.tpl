@@ -25,6 +25,8 @@ stats_config:
           regex: {{js $item}}
       {{- end}}
 {{- end }}
+cluster_manager:
+  local_cluster_name: local_cluster
 dynamic_resources:
   ads_config:
     api_type: DELTA_GRPC
@@ -125,6 +127,15 @@ static_resources:
                 address: {{ $sink.Address }}
                 port_value: {{ $sink.Port }}
   {{- end }}
+  - name: local_cluster
+    connect_timeout: 10s
+    type: EDS
+    eds_cluster_config:
+      service_name: local_cluster
+      eds_config:
+        ads: {}
+        resource_api_version: V3
+        initial_fetch_timeout: 1s
   - connect_timeout: 10s
     load_assignment:
       cluster_name: xds_cluster
@@ -165,6 +176,23 @@ static_resources:
               path_config_source:
                 path: "/sds/xds-trusted-ca.json"
               resource_api_version: V3
+{{- if .OverloadManager }}
+overload_manager:
+  refresh_interval: 0.25s
+  resource_monitors:
+  - name: {{ .OverloadManager.HeapResourceMonitor }}
+    typed_config:
+      "@type": type.googleapis.com/envoy.extensions.resource_monitors.fixed_heap.v3.FixedHeapConfig
+      max_heap_size_bytes: {{ .OverloadManager.MaxHeapSizeBytes }}
+  actions:
+  {{- range $_, $action := .OverloadManager.Actions }}
+  - name: {{ $action.Name }}
+    triggers:
+    - name: {{ $.OverloadManager.HeapResourceMonitor }}
+      threshold:
+        value: {{ $action.Threshold }}
+  {{- end }}
+{{- end }}
 layered_runtime:
   layers:
   - name: runtime-0
@@ -12,14 +12,17 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/pointer"
 
 	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
 )
 
 func TestGetRenderedBootstrapConfig(t *testing.T) {
 	cases := []struct {
-		name         string
-		proxyMetrics *egcfgv1a1.ProxyMetrics
+		name          string
+		proxyMetrics  *egcfgv1a1.ProxyMetrics
+		proxyOverload *egcfgv1a1.ProxyOverload
 	}{
 		{
 			name: "default",
@@ -68,11 +71,32 @@ func TestGetRenderedBootstrapConfig(t *testing.T) {
 				Prometheus: &egcfgv1a1.PrometheusProvider{},
 			},
 		},
+		{
+			name: "overload-manager",
+			proxyOverload: &egcfgv1a1.ProxyOverload{
+				MaxListenerConnections: pointer.Uint64(10000),
+				MaxHeapSize:            resourcePtr(resource.MustParse("1Gi")),
+				Actions: []egcfgv1a1.ProxyOverloadAction{
+					{
+						Type:                 egcfgv1a1.ShrinkHeapOverloadAction,
+						HeapThresholdPercent: 90,
+					},
+					{
+						Type:                 egcfgv1a1.DisableHTTPKeepAliveOverloadAction,
+						HeapThresholdPercent: 95,
+					},
+					{
+						Type:                 egcfgv1a1.StopAcceptingConnectionsOverloadAction,
+						HeapThresholdPercent: 98,
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			got, err := GetRenderedBootstrapConfig(tc.proxyMetrics)
+			got, err := GetRenderedBootstrapConfig(tc.proxyMetrics, tc.proxyOverload)
 			assert.NoError(t, err)
 			expected, err := readTestData(tc.name)
 			assert.NoError(t, err)
@@ -90,3 +114,7 @@ func readTestData(caseName string) (string, error) {
 	}
 	return string(b), nil
 }
+
+func resourcePtr(q resource.Quantity) *resource.Quantity {
+	return &q
+}
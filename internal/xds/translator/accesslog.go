@@ -7,18 +7,27 @@ package translator
 
 import (
 	"errors"
+	"fmt"
 	"sort"
 
 	accesslog "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v3"
 	cfgcore "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	fileaccesslog "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/file/v3"
 	grpcaccesslog "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/grpc/v3"
 	otelaccesslog "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/open_telemetry/v3"
+	streamaccesslog "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/stream/v3"
+	headertometadatav3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/header_to_metadata/v3"
+	hcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	matcherv3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	otlpcommonv1 "go.opentelemetry.io/proto/otlp/common/v1"
 	"golang.org/x/exp/maps"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/envoyproxy/gateway/internal/ir"
 	"github.com/envoyproxy/gateway/internal/xds/types"
@@ -45,6 +54,37 @@ const (
 
 	otelLogName   = "otel_envoy_accesslog"
 	otelAccessLog = "envoy.access_loggers.open_telemetry"
+
+	tcpGRPCAccessLog = "envoy.access_loggers.tcp_grpc"
+
+	stdoutAccessLog = "envoy.access_loggers.stdout"
+	stderrAccessLog = "envoy.access_loggers.stderr"
+
+	// The header to metadata filter sets the dynamic metadata of the requests
+	// on the routes with disabled access logs.
+	headerToMetadataFilter     = "envoy.filters.http.header_to_metadata"
+	accessLogMetadataNamespace = "envoy-gateway"
+	accessLogMetadataKey       = "access_log"
+	accessLogMetadataDisabled  = "disabled"
+
+	// accessLogRuntimeKeyPrefix prefixes the runtime keys of the conditions of
+	// the access log filters. The keys are not set in the runtime, so the
+	// default values of the filters apply.
+	accessLogRuntimeKeyPrefix = "access_log"
+)
+
+// accessLogKind is the kind of the traffic logged by an access log.
+type accessLogKind int
+
+const (
+	// accessLogKindHTTP logs the requests of a http connection manager.
+	accessLogKindHTTP accessLogKind = iota
+	// accessLogKindProxy logs the connections of a tcp proxy, or the
+	// sessions of a udp proxy.
+	accessLogKindProxy
+	// accessLogKindListener logs the connections of a listener without a
+	// matching filter chain.
+	accessLogKindListener
 )
 
 var (
@@ -54,17 +94,56 @@ var (
 			ResponseFlagFilter: &accesslog.ResponseFlagFilter{Flags: []string{"NR"}},
 		},
 	}
+
+	// skips the requests on the routes with disabled access logs
+	accessLogEnabledFilter = &accesslog.AccessLogFilter{
+		FilterSpecifier: &accesslog.AccessLogFilter_MetadataFilter{
+			MetadataFilter: &accesslog.MetadataFilter{
+				Matcher: &matcherv3.MetadataMatcher{
+					Filter: accessLogMetadataNamespace,
+					Path: []*matcherv3.MetadataMatcher_PathSegment{
+						{
+							Segment: &matcherv3.MetadataMatcher_PathSegment_Key{
+								Key: accessLogMetadataKey,
+							},
+						},
+					},
+					Value: &matcherv3.ValueMatcher{
+						MatchPattern: &matcherv3.ValueMatcher_StringMatch{
+							StringMatch: &matcherv3.StringMatcher{
+								MatchPattern: &matcherv3.StringMatcher_Exact{
+									Exact: accessLogMetadataDisabled,
+								},
+							},
+						},
+					},
+					Invert: true,
+				},
+				MatchIfKeyNotFound: wrapperspb.Bool(true),
+			},
+		},
+	}
 )
 
-func buildXdsAccessLog(al *ir.AccessLog, forListener bool) []*accesslog.AccessLog {
+// buildXdsAccessLog returns the access logs of the kind. The status code and
+// header conditions of the filters only match the requests of the http
+// connection managers, so the access logs of the other kinds are skipped if
+// their filters can't match without them. Likewise, the ALS access logs of the
+// HTTP type are only sent by the http connection managers, and the ones of the
+// TCP type by the other kinds.
+func buildXdsAccessLog(al *ir.AccessLog, kind accessLogKind) []*accesslog.AccessLog {
 	if al == nil {
 		return nil
 	}
 
-	totalLen := len(al.Text) + len(al.JSON) + len(al.OpenTelemetry)
+	totalLen := len(al.Text) + len(al.JSON) + len(al.OpenTelemetry) + len(al.ALS) + len(al.Stream)
 	accessLogs := make([]*accesslog.AccessLog, 0, totalLen)
 	// handle text file access logs
 	for _, text := range al.Text {
+		if !accessLogFilterMatchesKind(text.Filter, kind) {
+			continue
+		}
+
 		filelog := &fileaccesslog.FileAccessLog{
 			Path: text.Path,
 		}
@@ -92,10 +171,15 @@ func buildXdsAccessLog(al *ir.AccessLog, forListener bool) []*accesslog.AccessLo
 			ConfigType: &accesslog.AccessLog_TypedConfig{
 				TypedConfig: accesslogAny,
 			},
+			Filter: buildAccessLogFilter(text.Filter, kind),
 		})
 	}
 	// handle json file access logs
 	for _, json := range al.JSON {
+		if !accessLogFilterMatchesKind(json.Filter, kind) {
+			continue
+		}
+
 		jsonFormat := &structpb.Struct{
 			Fields: make(map[string]*structpb.Value, len(json.JSON)),
 		}
@@ -129,10 +213,15 @@ func buildXdsAccessLog(al *ir.AccessLog, forListener bool) []*accesslog.AccessLo
 			ConfigType: &accesslog.AccessLog_TypedConfig{
 				TypedConfig: accesslogAny,
 			},
+			Filter: buildAccessLogFilter(json.Filter, kind),
 		})
 	}
 	// handle open telemetry access logs
 	for _, otel := range al.OpenTelemetry {
+		if !accessLogFilterMatchesKind(otel.Filter, kind) {
+			continue
+		}
+
 		al := &otelaccesslog.OpenTelemetryAccessLogConfig{
 			CommonConfig: &grpcaccesslog.CommonGrpcAccessLogConfig{
 				LogName: otelLogName,
@@ -170,19 +259,332 @@ func buildXdsAccessLog(al *ir.AccessLog, forListener bool) []*accesslog.AccessLo
 			ConfigType: &accesslog.AccessLog_TypedConfig{
 				TypedConfig: accesslogAny,
 			},
+			Filter: buildAccessLogFilter(otel.Filter, kind),
+		})
+	}
+	// handle gRPC access log service access logs
+	for _, als := range al.ALS {
+		// The HTTP entries are only logged by the http connection managers.
+		if (als.Type == ir.ALSAccessLogTypeHTTP) != (kind == accessLogKindHTTP) ||
+			!accessLogFilterMatchesKind(als.Filter, kind) {
+			continue
+		}
+
+		commonConfig := &grpcaccesslog.CommonGrpcAccessLogConfig{
+			LogName: als.LogName,
+			GrpcService: &cfgcore.GrpcService{
+				TargetSpecifier: &cfgcore.GrpcService_EnvoyGrpc_{
+					EnvoyGrpc: &cfgcore.GrpcService_EnvoyGrpc{
+						ClusterName: buildClusterName("accesslog", als.Host, als.Port),
+						Authority:   als.Host,
+					},
+				},
+			},
+			TransportApiVersion: cfgcore.ApiVersion_V3,
+		}
+		if als.BufferFlushInterval != nil {
+			commonConfig.BufferFlushInterval = durationpb.New(als.BufferFlushInterval.Duration)
+		}
+		if als.BufferSizeBytes != nil {
+			commonConfig.BufferSizeBytes = wrapperspb.UInt32(*als.BufferSizeBytes)
+		}
+
+		name := wellknown.HTTPGRPCAccessLog
+		var accesslogAny *anypb.Any
+		switch als.Type {
+		case ir.ALSAccessLogTypeTCP:
+			name = tcpGRPCAccessLog
+			accesslogAny, _ = anypb.New(&grpcaccesslog.TcpGrpcAccessLogConfig{
+				CommonConfig: commonConfig,
+			})
+		default:
+			httpLog := &grpcaccesslog.HttpGrpcAccessLogConfig{
+				CommonConfig: commonConfig,
+			}
+			if als.HTTP != nil {
+				httpLog.AdditionalRequestHeadersToLog = als.HTTP.RequestHeaders
+				httpLog.AdditionalResponseHeadersToLog = als.HTTP.ResponseHeaders
+				httpLog.AdditionalResponseTrailersToLog = als.HTTP.ResponseTrailers
+			}
+			accesslogAny, _ = anypb.New(httpLog)
+		}
+
+		accessLogs = append(accessLogs, &accesslog.AccessLog{
+			Name: name,
+			ConfigType: &accesslog.AccessLog_TypedConfig{
+				TypedConfig: accesslogAny,
+			},
+			Filter: buildAccessLogFilter(als.Filter, kind),
 		})
 	}
+	// handle standard output and error access logs
+	for _, stream := range al.Stream {
+		if !accessLogFilterMatchesKind(stream.Filter, kind) {
+			continue
+		}
 
-	// add filter for listener access logs
-	if forListener {
-		for _, al := range accessLogs {
-			al.Filter = listenerAccessLogFilter
+		format := buildStreamAccessLogFormat(stream)
+
+		name := stdoutAccessLog
+		var accesslogAny *anypb.Any
+		switch stream.Type {
+		case ir.StreamAccessLogTypeStderr:
+			name = stderrAccessLog
+			accesslogAny, _ = anypb.New(&streamaccesslog.StderrAccessLog{
+				AccessLogFormat: &streamaccesslog.StderrAccessLog_LogFormat{LogFormat: format},
+			})
+		default:
+			accesslogAny, _ = anypb.New(&streamaccesslog.StdoutAccessLog{
+				AccessLogFormat: &streamaccesslog.StdoutAccessLog_LogFormat{LogFormat: format},
+			})
 		}
+
+		accessLogs = append(accessLogs, &accesslog.AccessLog{
+			Name: name,
+			ConfigType: &accesslog.AccessLog_TypedConfig{
+				TypedConfig: accesslogAny,
+			},
+			Filter: buildAccessLogFilter(stream.Filter, kind),
+		})
 	}
 
 	return accessLogs
 }
 
+// buildStreamAccessLogFormat returns the JSON format of the stream access log
+// if set, and its text format otherwise.
+func buildStreamAccessLogFormat(stream *ir.StreamAccessLog) *cfgcore.SubstitutionFormatString {
+	if len(stream.JSON) > 0 {
+		jsonFormat := &structpb.Struct{
+			Fields: make(map[string]*structpb.Value, len(stream.JSON)),
+		}
+		for key, value := range stream.JSON {
+			jsonFormat.Fields[key] = structpb.NewStringValue(value)
+		}
+		return &cfgcore.SubstitutionFormatString{
+			Format: &cfgcore.SubstitutionFormatString_JsonFormat{
+				JsonFormat: jsonFormat,
+			},
+		}
+	}
+
+	format := EnvoyTextLogFormat
+	if stream.Text != nil {
+		format = *stream.Text
+	}
+	return &cfgcore.SubstitutionFormatString{
+		Format: &cfgcore.SubstitutionFormatString_TextFormatSource{
+			TextFormatSource: &cfgcore.DataSource{
+				Specifier: &cfgcore.DataSource_InlineString{
+					InlineString: format,
+				},
+			},
+		},
+	}
+}
+
+// buildAccessLogFilter returns the filter of an access log. The listener access
+// logs only log the requests without a route, in addition to the filter.
+func buildAccessLogFilter(filter *ir.AccessLogFilter, kind accessLogKind) *accesslog.AccessLogFilter {
+	xdsFilter := buildXdsAccessLogFilter(filter, kind)
+	if kind != accessLogKindListener {
+		return xdsFilter
+	}
+	if xdsFilter == nil {
+		return listenerAccessLogFilter
+	}
+
+	return &accesslog.AccessLogFilter{
+		FilterSpecifier: &accesslog.AccessLogFilter_AndFilter{
+			AndFilter: &accesslog.AndFilter{
+				Filters: []*accesslog.AccessLogFilter{listenerAccessLogFilter, xdsFilter},
+			},
+		},
+	}
+}
+
+// accessLogFilterMatchesKind returns false if the filter can't match any entry
+// of the kind, i.e. outside of the http connection managers, if one of its and
+// conditions, or all of its or conditions, are status code or header conditions.
+func accessLogFilterMatchesKind(filter *ir.AccessLogFilter, kind accessLogKind) bool {
+	if filter == nil || len(filter.Conditions) == 0 || kind == accessLogKindHTTP {
+		return true
+	}
+
+	for _, condition := range filter.Conditions {
+		if accessLogConditionMatchesKind(condition, kind) == filter.Or {
+			return filter.Or
+		}
+	}
+	return !filter.Or
+}
+
+// accessLogConditionMatchesKind returns false for the status code and header
+// conditions outside of the http connection managers, since there are no status
+// codes or headers to match.
+func accessLogConditionMatchesKind(condition *ir.AccessLogFilterCondition, kind accessLogKind) bool {
+	return kind == accessLogKindHTTP || (condition.StatusCode == nil && condition.Header == nil)
+}
+
+// buildXdsAccessLogFilter combines the filters of the conditions with an and
+// filter, or an or filter. The conditions that can't match any entry of the kind
+// are left out, which only leaves the or filter unchanged, so the access logs
+// whose filters can't match the kind must be skipped before.
+func buildXdsAccessLogFilter(filter *ir.AccessLogFilter, kind accessLogKind) *accesslog.AccessLogFilter {
+	if filter == nil || len(filter.Conditions) == 0 {
+		return nil
+	}
+
+	filters := make([]*accesslog.AccessLogFilter, 0, len(filter.Conditions))
+	for i, condition := range filter.Conditions {
+		if !accessLogConditionMatchesKind(condition, kind) {
+			continue
+		}
+		xdsFilter := buildAccessLogConditionFilter(condition, accessLogConditionRuntimeKeyPrefix(filter, i))
+		if xdsFilter == nil {
+			continue
+		}
+		// Flatten the and filter of a status code range into the and filter
+		// of the conditions.
+		if andFilter := xdsFilter.GetAndFilter(); andFilter != nil && !filter.Or {
+			filters = append(filters, andFilter.Filters...)
+			continue
+		}
+		filters = append(filters, xdsFilter)
+	}
+
+	switch {
+	case len(filters) == 0:
+		return nil
+	// The and and or filters require at least two filters.
+	case len(filters) == 1:
+		return filters[0]
+	case filter.Or:
+		return &accesslog.AccessLogFilter{
+			FilterSpecifier: &accesslog.AccessLogFilter_OrFilter{
+				OrFilter: &accesslog.OrFilter{Filters: filters},
+			},
+		}
+	default:
+		return &accesslog.AccessLogFilter{
+			FilterSpecifier: &accesslog.AccessLogFilter_AndFilter{
+				AndFilter: &accesslog.AndFilter{Filters: filters},
+			},
+		}
+	}
+}
+
+// accessLogConditionRuntimeKeyPrefix returns the prefix of the runtime keys of
+// the condition of the filter at the given index, so that each condition can be
+// overridden on its own.
+func accessLogConditionRuntimeKeyPrefix(filter *ir.AccessLogFilter, index int) string {
+	return fmt.Sprintf("%s.%s.condition.%d", accessLogRuntimeKeyPrefix, filter.Name, index)
+}
+
+func buildAccessLogConditionFilter(condition *ir.AccessLogFilterCondition, runtimeKeyPrefix string) *accesslog.AccessLogFilter {
+	switch {
+	case condition.StatusCode != nil:
+		return buildAccessLogStatusCodeFilter(condition.StatusCode, runtimeKeyPrefix)
+	case condition.MinDuration != nil:
+		return &accesslog.AccessLogFilter{
+			FilterSpecifier: &accesslog.AccessLogFilter_DurationFilter{
+				DurationFilter: &accesslog.DurationFilter{
+					Comparison: &accesslog.ComparisonFilter{
+						Op: accesslog.ComparisonFilter_GE,
+						Value: &cfgcore.RuntimeUInt32{
+							DefaultValue: uint32(condition.MinDuration.Milliseconds()),
+							RuntimeKey:   runtimeKeyPrefix + ".min_duration",
+						},
+					},
+				},
+			},
+		}
+	case len(condition.ResponseFlags) > 0:
+		return &accesslog.AccessLogFilter{
+			FilterSpecifier: &accesslog.AccessLogFilter_ResponseFlagFilter{
+				ResponseFlagFilter: &accesslog.ResponseFlagFilter{Flags: condition.ResponseFlags},
+			},
+		}
+	case condition.Header != nil:
+		headerMatcher := &routev3.HeaderMatcher{
+			Name: condition.Header.Name,
+		}
+		if condition.Header.Value != nil {
+			headerMatcher.HeaderMatchSpecifier = &routev3.HeaderMatcher_StringMatch{
+				StringMatch: &matcherv3.StringMatcher{
+					MatchPattern: &matcherv3.StringMatcher_Exact{
+						Exact: *condition.Header.Value,
+					},
+				},
+			}
+		} else {
+			headerMatcher.HeaderMatchSpecifier = &routev3.HeaderMatcher_PresentMatch{
+				PresentMatch: true,
+			}
+		}
+		return &accesslog.AccessLogFilter{
+			FilterSpecifier: &accesslog.AccessLogFilter_HeaderFilter{
+				HeaderFilter: &accesslog.HeaderFilter{Header: headerMatcher},
+			},
+		}
+	case condition.SamplePercent != nil:
+		return &accesslog.AccessLogFilter{
+			FilterSpecifier: &accesslog.AccessLogFilter_RuntimeFilter{
+				RuntimeFilter: &accesslog.RuntimeFilter{
+					RuntimeKey: runtimeKeyPrefix + ".sample_percent",
+					PercentSampled: &typev3.FractionalPercent{
+						Numerator:   *condition.SamplePercent,
+						Denominator: typev3.FractionalPercent_HUNDRED,
+					},
+				},
+			},
+		}
+	default:
+		return nil
+	}
+}
+
+// buildAccessLogStatusCodeFilter returns a status code filter for each bound of
+// the range, combined with an and filter if both bounds are set.
+func buildAccessLogStatusCodeFilter(statusCode *ir.StatusCodeRange, runtimeKeyPrefix string) *accesslog.AccessLogFilter {
+	statusCodeFilter := func(op accesslog.ComparisonFilter_Op, value uint32, runtimeKey string) *accesslog.AccessLogFilter {
+		return &accesslog.AccessLogFilter{
+			FilterSpecifier: &accesslog.AccessLogFilter_StatusCodeFilter{
+				StatusCodeFilter: &accesslog.StatusCodeFilter{
+					Comparison: &accesslog.ComparisonFilter{
+						Op: op,
+						Value: &cfgcore.RuntimeUInt32{
+							DefaultValue: value,
+							RuntimeKey:   runtimeKey,
+						},
+					},
+				},
+			},
+		}
+	}
+
+	var filters []*accesslog.AccessLogFilter
+	if statusCode.Min > 0 {
+		filters = append(filters, statusCodeFilter(accesslog.ComparisonFilter_GE, statusCode.Min, runtimeKeyPrefix+".status_code_min"))
+	}
+	if statusCode.Max > 0 {
+		filters = append(filters, statusCodeFilter(accesslog.ComparisonFilter_LE, statusCode.Max, runtimeKeyPrefix+".status_code_max"))
+	}
+
+	switch len(filters) {
+	case 0:
+		return nil
+	case 1:
+		return filters[0]
+	default:
+		return &accesslog.AccessLogFilter{
+			FilterSpecifier: &accesslog.AccessLogFilter_AndFilter{
+				AndFilter: &accesslog.AndFilter{Filters: filters},
+			},
+		}
+	}
+}
+
 // read more here: https://opentelemetry.io/docs/specs/otel/resource/semantic_conventions/k8s/
 const (
 	k8sNamespaceNameKey = "k8s.namespace.name"
@@ -232,26 +634,173 @@ func convertToKeyValueList(attributes map[string]string, additionalLabels bool)
 	return keyValueList
 }
 
-func processClusterForAccessLog(tCtx *types.ResourceVersionTable, al *ir.AccessLog) error {
+func processClusterForAccessLog(tCtx *types.ResourceVersionTable, xdsIR *ir.Xds) error {
+	for _, httpListener := range xdsIR.HTTP {
+		if err := addAccessLogClusters(tCtx, httpListener.AccessLog); err != nil {
+			return err
+		}
+	}
+	for _, tcpListener := range xdsIR.TCP {
+		if err := addAccessLogClusters(tCtx, tcpListener.AccessLog); err != nil {
+			return err
+		}
+	}
+	for _, udpListener := range xdsIR.UDP {
+		if err := addAccessLogClusters(tCtx, udpListener.AccessLog); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// addAccessLogClusters adds the clusters of the gRPC access log sinks of a listener.
+func addAccessLogClusters(tCtx *types.ResourceVersionTable, al *ir.AccessLog) error {
 	if al == nil {
 		return nil
 	}
 
 	for _, otel := range al.OpenTelemetry {
-		clusterName := buildClusterName("accesslog", otel.Host, otel.Port)
-
-		endpoints := []*ir.DestinationEndpoint{ir.NewDestEndpoint(otel.Host, otel.Port)}
-		if err := addXdsCluster(tCtx, addXdsClusterArgs{
-			name:         clusterName,
-			endpoints:    endpoints,
-			tSocket:      nil,
-			protocol:     HTTP2,
-			endpointType: DefaultEndpointType,
-		}); err != nil && !errors.Is(err, ErrXdsClusterExists) {
+		if err := addAccessLogCluster(tCtx, otel.Host, otel.Port); err != nil {
+			return err
+		}
+	}
+
+	for _, als := range al.ALS {
+		if err := addAccessLogCluster(tCtx, als.Host, als.Port); err != nil {
 			return err
 		}
+	}
+
+	return nil
+}
+
+// addAccessLogCluster adds the cluster of a gRPC access log sink, unless an
+// access log sink with the same host and port already added it.
+func addAccessLogCluster(tCtx *types.ResourceVersionTable, host string, port uint32) error {
+	clusterName := buildClusterName("accesslog", host, port)
+
+	endpoints := []*ir.DestinationEndpoint{ir.NewDestEndpoint(host, port)}
+	if err := addXdsCluster(tCtx, addXdsClusterArgs{
+		name:         clusterName,
+		endpoints:    endpoints,
+		tSocket:      nil,
+		protocol:     HTTP2,
+		endpointType: DefaultEndpointType,
+	}); err != nil && !errors.Is(err, ErrXdsClusterExists) {
+		return err
+	}
+
+	return nil
+}
+
+// patchHCMWithAccessLogDisable adds the header to metadata filter to the http
+// connection manager, if the listener has routes with disabled access logs.
+// The filter is configured without rules, so it only sets the dynamic metadata
+// of the requests on the routes enabling it with their per route config, and
+// the access logs of the http connection manager skip these requests.
+func patchHCMWithAccessLogDisable(mgr *hcmv3.HttpConnectionManager, irListener *ir.HTTPListener) error {
+	if mgr == nil {
+		return errors.New("hcm is nil")
+	}
+
+	if irListener == nil {
+		return errors.New("ir listener is nil")
+	}
+
+	if len(mgr.AccessLog) == 0 || !listenerContainsAccessLogDisable(irListener) {
+		return nil
+	}
+
+	// Return early if filter already exists.
+	for _, httpFilter := range mgr.HttpFilters {
+		if httpFilter.Name == headerToMetadataFilter {
+			return nil
+		}
+	}
+
+	headerToMetadataAny, err := anypb.New(&headertometadatav3.Config{})
+	if err != nil {
+		return err
+	}
+
+	mgr.HttpFilters = append(mgr.HttpFilters, &hcmv3.HttpFilter{
+		Name: headerToMetadataFilter,
+		ConfigType: &hcmv3.HttpFilter_TypedConfig{
+			TypedConfig: headerToMetadataAny,
+		},
+	})
+
+	for _, al := range mgr.AccessLog {
+		if al.Filter == nil {
+			al.Filter = accessLogEnabledFilter
+			continue
+		}
+		if andFilter := al.Filter.GetAndFilter(); andFilter != nil {
+			andFilter.Filters = append(andFilter.Filters, accessLogEnabledFilter)
+			continue
+		}
+		al.Filter = &accesslog.AccessLogFilter{
+			FilterSpecifier: &accesslog.AccessLogFilter_AndFilter{
+				AndFilter: &accesslog.AndFilter{
+					Filters: []*accesslog.AccessLogFilter{al.Filter, accessLogEnabledFilter},
+				},
+			},
+		}
+	}
+
+	return nil
+}
+
+// listenerContainsAccessLogDisable returns true if the provided listener has
+// routes with disabled access logs.
+func listenerContainsAccessLogDisable(irListener *ir.HTTPListener) bool {
+	for _, route := range irListener.Routes {
+		if route.DisableAccessLog {
+			return true
+		}
+	}
+	return false
+}
+
+// patchRouteWithAccessLogDisable enables the header to metadata filter on the
+// route, if the route has disabled access logs. The per route config of the
+// filter marks the requests on the route as not logged, whether the matched
+// header is present or not.
+func patchRouteWithAccessLogDisable(route *routev3.Route, irRoute *ir.HTTPRoute) error {
+	if route == nil {
+		return errors.New("xds route is nil")
+	}
+	if irRoute == nil {
+		return errors.New("ir route is nil")
+	}
+	if !irRoute.DisableAccessLog {
+		return nil
+	}
+
+	disabled := &headertometadatav3.Config_KeyValuePair{
+		MetadataNamespace: accessLogMetadataNamespace,
+		Key:               accessLogMetadataKey,
+		Value:             accessLogMetadataDisabled,
+		Type:              headertometadatav3.Config_STRING,
+	}
+	routeCfgAny, err := anypb.New(&headertometadatav3.Config{
+		RequestRules: []*headertometadatav3.Config_Rule{
+			{
+				Header:          ":path",
+				OnHeaderPresent: disabled,
+				OnHeaderMissing: disabled,
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
 
+	if route.TypedPerFilterConfig == nil {
+		route.TypedPerFilterConfig = make(map[string]*anypb.Any)
 	}
+	route.TypedPerFilterConfig[headerToMetadataFilter] = routeCfgAny
 
 	return nil
 }
@@ -7,6 +7,8 @@ package translator
 
 import (
 	"fmt"
+	"net"
+	"sort"
 	"time"
 
 	clusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
@@ -14,6 +16,7 @@ import (
 	endpointv3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
 	httpv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/upstreams/http/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	"golang.org/x/exp/slices"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
@@ -61,15 +64,24 @@ func buildXdsCluster(clusterName string, tSocket *corev3.TransportSocket, protoc
 		cluster.RespectDnsTtl = true
 	}
 
-	if protocol == HTTP2 {
-		cluster.TypedExtensionProtocolOptions = buildTypedExtensionProtocolOptions()
+	switch protocol {
+	case HTTP2:
+		cluster.TypedExtensionProtocolOptions = buildTypedExtensionProtocolOptions(false)
+	case HTTP2WebSocket:
+		cluster.TypedExtensionProtocolOptions = buildTypedExtensionProtocolOptions(true)
 	}
 
 	return cluster
 }
 
 func buildXdsClusterLoadAssignment(clusterName string, irEndpoints []*ir.DestinationEndpoint) *endpointv3.ClusterLoadAssignment {
-	endpoints := make([]*endpointv3.LbEndpoint, 0, len(irEndpoints))
+	// Group the endpoints into a locality per priority and zone.
+	type localityKey struct {
+		priority uint32
+		zone     string
+	}
+	var keys []localityKey
+	localityEndpoints := make(map[localityKey][]*endpointv3.LbEndpoint)
 	for _, irEp := range irEndpoints {
 		lbEndpoint := &endpointv3.LbEndpoint{
 			HostIdentifier: &endpointv3.LbEndpoint_Endpoint{
@@ -91,28 +103,141 @@ func buildXdsClusterLoadAssignment(clusterName string, irEndpoints []*ir.Destina
 		if irEp.Weight != nil {
 			lbEndpoint.LoadBalancingWeight = &wrapperspb.UInt32Value{Value: *irEp.Weight}
 		}
-		endpoints = append(endpoints, lbEndpoint)
+
+		key := localityKey{zone: endpointLocalityZone(irEp)}
+		if irEp.Priority != nil {
+			key.priority = *irEp.Priority
+		}
+		if _, ok := localityEndpoints[key]; !ok {
+			keys = append(keys, key)
+		}
+		localityEndpoints[key] = append(localityEndpoints[key], lbEndpoint)
 	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].priority != keys[j].priority {
+			return keys[i].priority < keys[j].priority
+		}
+		return keys[i].zone < keys[j].zone
+	})
 
-	localities := make([]*endpointv3.LocalityLbEndpoints, 0, 1)
-	locality := &endpointv3.LocalityLbEndpoints{
-		Locality:    &corev3.Locality{},
-		LbEndpoints: endpoints,
-		Priority:    0,
-		// Each locality gets the same weight 1. There is a single locality
-		// per priority, so the weight value does not really matter, but some
-		// load balancers need the value to be set.
-		LoadBalancingWeight: &wrapperspb.UInt32Value{Value: 1}}
-	localities = append(localities, locality)
+	localities := make([]*endpointv3.LocalityLbEndpoints, 0, len(keys))
+	// Envoy requires the priorities to be contiguous from 0, so the priorities
+	// without endpoints are skipped.
+	var priority uint32
+	for i, key := range keys {
+		if i > 0 && key.priority != keys[i-1].priority {
+			priority++
+		}
+		locality := &endpointv3.LocalityLbEndpoints{
+			Locality:    &corev3.Locality{Zone: key.zone},
+			LbEndpoints: localityEndpoints[key],
+			Priority:    priority,
+			// Each locality gets the same weight 1. The localities are only
+			// weighted when the endpoints are not spread across zones, in which
+			// case there is a single locality, so the weight value does not
+			// really matter, but some load balancers need the value to be set.
+			LoadBalancingWeight: &wrapperspb.UInt32Value{Value: 1}}
+		localities = append(localities, locality)
+	}
+	if len(localities) == 0 {
+		localities = append(localities, &endpointv3.LocalityLbEndpoints{
+			Locality:            &corev3.Locality{},
+			LbEndpoints:         []*endpointv3.LbEndpoint{},
+			Priority:            0,
+			LoadBalancingWeight: &wrapperspb.UInt32Value{Value: 1}})
+	}
 
 	return &endpointv3.ClusterLoadAssignment{ClusterName: clusterName, Endpoints: localities}
 }
 
-func buildTypedExtensionProtocolOptions() map[string]*anypb.Any {
+// buildEndpointType returns the type of the cluster of the endpoints. The endpoints
+// are discovered with EDS, unless some are hostnames, e.g. of an ExternalName Service,
+// which are resolved by Envoy with DNS.
+func buildEndpointType(irEndpoints []*ir.DestinationEndpoint) EndpointType {
+	for _, irEp := range irEndpoints {
+		if net.ParseIP(irEp.Host) == nil {
+			return DefaultEndpointType
+		}
+	}
+	return Static
+}
+
+// endpointLocalityZone returns the zone of the locality of the endpoint. An endpoint
+// allocated to other zones by topology aware routing serves the requests of these zones.
+// An endpoint belongs to a single locality, so an endpoint allocated to several zones
+// stays in its own zone if it is one of them, and moves to the first of them in
+// alphabetical order otherwise.
+func endpointLocalityZone(irEp *ir.DestinationEndpoint) string {
+	if len(irEp.ZoneHints) > 0 {
+		if irEp.Zone != nil && slices.Contains(irEp.ZoneHints, *irEp.Zone) {
+			return *irEp.Zone
+		}
+		zone := irEp.ZoneHints[0]
+		for _, hint := range irEp.ZoneHints[1:] {
+			if hint < zone {
+				zone = hint
+			}
+		}
+		return zone
+	}
+	if irEp.Zone != nil {
+		return *irEp.Zone
+	}
+	return ""
+}
+
+// endpointsHaveZones returns true if the zones of some endpoints are known.
+func endpointsHaveZones(irEndpoints []*ir.DestinationEndpoint) bool {
+	for _, irEp := range irEndpoints {
+		if endpointLocalityZone(irEp) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// endpointsHavePriorities returns true if some endpoints are failover endpoints.
+func endpointsHavePriorities(irEndpoints []*ir.DestinationEndpoint) bool {
+	for _, irEp := range irEndpoints {
+		if irEp.Priority != nil && *irEp.Priority > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// enableFailover lets the outlier detection eject all the endpoints of a priority,
+// instead of 10% of the endpoints of the cluster, so that the requests fail over to
+// the next priority when the endpoints of a priority fail.
+func enableFailover(cluster *clusterv3.Cluster) {
+	cluster.OutlierDetection.MaxEjectionPercent = &wrapperspb.UInt32Value{Value: 100}
+}
+
+// enableZoneAwareRouting routes the requests of the cluster to the endpoints in
+// the zone of Envoy, as long as they can handle the share of the requests of
+// the Envoy proxies in this zone. Locality weighted load balancing must be
+// disabled for zone aware routing to apply.
+func enableZoneAwareRouting(cluster *clusterv3.Cluster) {
+	cluster.CommonLbConfig.LocalityConfigSpecifier = &clusterv3.Cluster_CommonLbConfig_ZoneAwareLbConfig_{
+		ZoneAwareLbConfig: &clusterv3.Cluster_CommonLbConfig_ZoneAwareLbConfig{},
+	}
+}
+
+// buildTypedExtensionProtocolOptions returns the protocol options to forward the requests
+// over HTTP2. allowConnect enables the extended CONNECT method, which tunnels the WebSocket
+// upgrades over HTTP2.
+func buildTypedExtensionProtocolOptions(allowConnect bool) map[string]*anypb.Any {
+	http2ProtocolOptions := &httpv3.HttpProtocolOptions_ExplicitHttpConfig_Http2ProtocolOptions{}
+	if allowConnect {
+		http2ProtocolOptions.Http2ProtocolOptions = &corev3.Http2ProtocolOptions{
+			AllowConnect: true,
+		}
+	}
+
 	protocolOptions := httpv3.HttpProtocolOptions{
 		UpstreamProtocolOptions: &httpv3.HttpProtocolOptions_ExplicitHttpConfig_{
 			ExplicitHttpConfig: &httpv3.HttpProtocolOptions_ExplicitHttpConfig{
-				ProtocolConfig: &httpv3.HttpProtocolOptions_ExplicitHttpConfig_Http2ProtocolOptions{},
+				ProtocolConfig: http2ProtocolOptions,
 			},
 		},
 	}
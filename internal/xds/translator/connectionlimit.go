@@ -0,0 +1,76 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	listenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	connectionlimitv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/connection_limit/v3"
+	resourcev3 "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/xds/types"
+)
+
+const (
+	connectionLimitFilter           = "envoy.filters.network.connection_limit"
+	connectionLimitFilterStatPrefix = "connection_limit"
+)
+
+// processConnectionLimit adds the connection limit filter in front of the
+// filters of every filter chain of the TCP listeners. Each filter chain counts
+// its own connections, so the limit applies per filter chain, not per listener.
+// UDP listeners have no connections to limit, and are left unchanged.
+func processConnectionLimit(tCtx *types.ResourceVersionTable, connectionLimit *ir.ConnectionLimit) error {
+	if connectionLimit == nil {
+		return nil
+	}
+
+	connectionLimitAny, err := anypb.New(&connectionlimitv3.ConnectionLimit{
+		StatPrefix:     connectionLimitFilterStatPrefix,
+		MaxConnections: wrapperspb.UInt64(connectionLimit.MaxConnections),
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, r := range tCtx.XdsResources[resourcev3.ListenerType] {
+		listener := r.(*listenerv3.Listener)
+		if listener.GetAddress().GetSocketAddress().GetProtocol() != corev3.SocketAddress_TCP {
+			continue
+		}
+
+		for _, filterChain := range listener.FilterChains {
+			patchFilterChainWithConnectionLimit(filterChain, connectionLimitAny)
+		}
+		if listener.DefaultFilterChain != nil {
+			patchFilterChainWithConnectionLimit(listener.DefaultFilterChain, connectionLimitAny)
+		}
+	}
+
+	return nil
+}
+
+// patchFilterChainWithConnectionLimit prepends the connection limit filter to
+// the filters of the filter chain, so the connections over the limit are closed
+// before reaching the other filters.
+func patchFilterChainWithConnectionLimit(filterChain *listenerv3.FilterChain, connectionLimitAny *anypb.Any) {
+	// Return early if filter already exists.
+	for _, filter := range filterChain.Filters {
+		if filter.Name == connectionLimitFilter {
+			return
+		}
+	}
+
+	filterChain.Filters = append([]*listenerv3.Filter{{
+		Name: connectionLimitFilter,
+		ConfigType: &listenerv3.Filter_TypedConfig{
+			TypedConfig: connectionLimitAny,
+		},
+	}}, filterChain.Filters...)
+}
@@ -0,0 +1,114 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"errors"
+
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	transcoderv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/grpc_json_transcoder/v3"
+	hcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+// patchHCMWithGRPCJSONTranscoderFilter adds the gRPC-JSON transcoder filter to the
+// http connection manager, if the listener has routes with a transcoder.
+// The filter is configured without services, so it is disabled unless a route
+// enables it with its per route config.
+func patchHCMWithGRPCJSONTranscoderFilter(mgr *hcmv3.HttpConnectionManager, irListener *ir.HTTPListener) error {
+	if mgr == nil {
+		return errors.New("hcm is nil")
+	}
+
+	if irListener == nil {
+		return errors.New("ir listener is nil")
+	}
+
+	if !listenerContainsGRPCJSONTranscoder(irListener) {
+		return nil
+	}
+
+	// Return early if filter already exists.
+	for _, httpFilter := range mgr.HttpFilters {
+		if httpFilter.Name == wellknown.GRPCJSONTranscoder {
+			return nil
+		}
+	}
+
+	transcoderAny, err := anypb.New(&transcoderv3.GrpcJsonTranscoder{
+		DescriptorSet: &transcoderv3.GrpcJsonTranscoder_ProtoDescriptorBin{
+			ProtoDescriptorBin: []byte{},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	mgr.HttpFilters = append(mgr.HttpFilters, &hcmv3.HttpFilter{
+		Name: wellknown.GRPCJSONTranscoder,
+		ConfigType: &hcmv3.HttpFilter_TypedConfig{
+			TypedConfig: transcoderAny,
+		},
+	})
+
+	return nil
+}
+
+// listenerContainsGRPCJSONTranscoder returns true if the provided listener has
+// routes with a gRPC-JSON transcoder.
+func listenerContainsGRPCJSONTranscoder(irListener *ir.HTTPListener) bool {
+	for _, route := range irListener.Routes {
+		if route.GRPC != nil && route.GRPC.JSONTranscoder != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// patchRouteWithGRPCJSONTranscoder enables the gRPC-JSON transcoder filter on the route,
+// if the route has a transcoder.
+func patchRouteWithGRPCJSONTranscoder(route *routev3.Route, irRoute *ir.HTTPRoute) error {
+	if route == nil {
+		return errors.New("xds route is nil")
+	}
+	if irRoute == nil {
+		return errors.New("ir route is nil")
+	}
+	if irRoute.GRPC == nil || irRoute.GRPC.JSONTranscoder == nil {
+		return nil
+	}
+
+	transcoder := irRoute.GRPC.JSONTranscoder
+	routeCfgProto := &transcoderv3.GrpcJsonTranscoder{
+		DescriptorSet: &transcoderv3.GrpcJsonTranscoder_ProtoDescriptorBin{
+			ProtoDescriptorBin: transcoder.DescriptorSet,
+		},
+		Services: transcoder.Services,
+	}
+	if options := transcoder.PrintOptions; options != nil {
+		routeCfgProto.PrintOptions = &transcoderv3.GrpcJsonTranscoder_PrintOptions{
+			AddWhitespace:              options.AddWhitespace,
+			AlwaysPrintPrimitiveFields: options.AlwaysPrintPrimitiveFields,
+			AlwaysPrintEnumsAsInts:     options.AlwaysPrintEnumsAsInts,
+			PreserveProtoFieldNames:    options.PreserveProtoFieldNames,
+		}
+	}
+
+	routeCfgAny, err := anypb.New(routeCfgProto)
+	if err != nil {
+		return err
+	}
+
+	if route.TypedPerFilterConfig == nil {
+		route.TypedPerFilterConfig = make(map[string]*anypb.Any)
+	}
+	route.TypedPerFilterConfig[wellknown.GRPCJSONTranscoder] = routeCfgAny
+
+	return nil
+}
@@ -12,6 +12,7 @@ import (
 	matcher "github.com/cncf/xds/go/xds/type/matcher/v3"
 	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	listenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	tls_inspectorv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/listener/tls_inspector/v3"
 	hcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	tcpv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
@@ -21,6 +22,7 @@ import (
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/envoyproxy/gateway/internal/ir"
@@ -54,7 +56,7 @@ func http2ProtocolOptions() *corev3.Http2ProtocolOptions {
 }
 
 func buildXdsTCPListener(name, address string, port uint32, accesslog *ir.AccessLog) *listenerv3.Listener {
-	al := buildXdsAccessLog(accesslog, true)
+	al := buildXdsAccessLog(accesslog, accessLogKindListener)
 	return &listenerv3.Listener{
 		Name:                          name,
 		AccessLog:                     al,
@@ -74,8 +76,8 @@ func buildXdsTCPListener(name, address string, port uint32, accesslog *ir.Access
 }
 
 func (t *Translator) addXdsHTTPFilterChain(xdsListener *listenerv3.Listener, irListener *ir.HTTPListener,
-	accesslog *ir.AccessLog, tracing *ir.Tracing) error {
-	al := buildXdsAccessLog(accesslog, false)
+	tracing *ir.Tracing) error {
+	al := buildXdsAccessLog(irListener.AccessLog, accessLogKindHTTP)
 
 	hcmTracing, err := buildHCMTracing(tracing)
 	if err != nil {
@@ -116,26 +118,7 @@ func (t *Translator) addXdsHTTPFilterChain(xdsListener *listenerv3.Listener, irL
 		Tracing: hcmTracing,
 	}
 
-	if irListener.IsHTTP2 {
-		mgr.HttpFilters = append(mgr.HttpFilters, xdsfilters.GRPCWeb)
-		// always enable grpc stats filter
-		mgr.HttpFilters = append(mgr.HttpFilters, xdsfilters.GRPCStats)
-	} else {
-		// Allow websocket upgrades for HTTP 1.1
-		// Reference: https://developer.mozilla.org/en-US/docs/Web/HTTP/Protocol_upgrade_mechanism
-		mgr.UpgradeConfigs = []*hcmv3.HttpConnectionManager_UpgradeConfig{
-			{
-				UpgradeType: "websocket",
-			},
-		}
-	}
-
-	// TODO: Make this a generic interface for all API Gateway features.
-	//       https://github.com/envoyproxy/gateway/issues/882
-	t.patchHCMWithRateLimit(mgr, irListener)
-
-	// Add the jwt authn filter, if needed.
-	if err := patchHCMWithJwtAuthnFilter(mgr, irListener); err != nil {
+	if err := t.patchHCMWithListenerFeatures(mgr, irListener); err != nil {
 		return err
 	}
 
@@ -178,6 +161,45 @@ func (t *Translator) addXdsHTTPFilterChain(xdsListener *listenerv3.Listener, irL
 	return nil
 }
 
+// patchHCMWithListenerFeatures patches the http connection manager with the filters
+// and the settings required by the routes of the IR listener. It only adds what the
+// http connection manager doesn't have yet, so it can be shared by several IR listeners.
+func (t *Translator) patchHCMWithListenerFeatures(mgr *hcmv3.HttpConnectionManager, irListener *ir.HTTPListener) error {
+	// Add the grpc json transcoder filter, if needed.
+	if err := patchHCMWithGRPCJSONTranscoderFilter(mgr, irListener); err != nil {
+		return err
+	}
+
+	// Enable the gRPC filters and the websocket upgrades required by the routes.
+	patchHCMWithProtocolFeatures(mgr, irListener)
+
+	// Add the local rate limit filter, if needed.
+	if err := patchHCMWithLocalRateLimitFilter(mgr, irListener); err != nil {
+		return err
+	}
+
+	// TODO: Make this a generic interface for all API Gateway features.
+	//       https://github.com/envoyproxy/gateway/issues/882
+	t.patchHCMWithRateLimit(mgr, irListener)
+
+	// Add the jwt authn filter, if needed.
+	if err := patchHCMWithJwtAuthnFilter(mgr, irListener); err != nil {
+		return err
+	}
+
+	// Add the filter disabling the access logs of the routes, if needed.
+	if err := patchHCMWithAccessLogDisable(mgr, irListener); err != nil {
+		return err
+	}
+
+	// Set the local reply config, if not set by the IR listeners added before.
+	if mgr.LocalReplyConfig == nil {
+		mgr.LocalReplyConfig = buildXdsLocalReplyConfig(irListener.LocalReply)
+	}
+
+	return nil
+}
+
 func addServerNamesMatch(xdsListener *listenerv3.Listener, filterChain *listenerv3.FilterChain, hostnames []string) error {
 	// Dont add a filter chain match if the hostname is a wildcard character.
 	if len(hostnames) > 0 && hostnames[0] != "*" {
@@ -217,7 +239,58 @@ func findXdsHTTPRouteConfigName(xdsListener *listenerv3.Listener) string {
 	return ""
 }
 
-func addXdsTCPFilterChain(xdsListener *listenerv3.Listener, irListener *ir.TCPListener, clusterName string, accesslog *ir.AccessLog) error {
+// patchXdsHTTPFilterChain patches the http connection manager of the default
+// filter chain of the listener, shared with the IR listeners added before, with
+// the filters required by the routes of the IR listener joining it. The gRPC filters
+// and the WebSocket upgrades it enables are disabled for the routes of the route
+// config added before. It returns whether the gRPC filters and the WebSocket upgrades
+// are enabled on the patched http connection manager.
+func (t *Translator) patchXdsHTTPFilterChain(xdsListener *listenerv3.Listener, irListener *ir.HTTPListener,
+	xdsRouteCfg *routev3.RouteConfiguration) (bool, bool, error) {
+	if xdsListener == nil || xdsListener.DefaultFilterChain == nil {
+		return false, false, errors.New("default filter chain is nil")
+	}
+
+	for _, filter := range xdsListener.DefaultFilterChain.Filters {
+		if filter.Name != wellknown.HTTPConnectionManager {
+			continue
+		}
+		mgr := new(hcmv3.HttpConnectionManager)
+		if err := filter.GetTypedConfig().UnmarshalTo(mgr); err != nil {
+			return false, false, err
+		}
+
+		// Keep the router filter as the last one.
+		if len(mgr.HttpFilters) == 0 {
+			return false, false, errors.New("hcm has no router filter")
+		}
+		router := mgr.HttpFilters[len(mgr.HttpFilters)-1]
+		mgr.HttpFilters = mgr.HttpFilters[:len(mgr.HttpFilters)-1]
+
+		servedGRPC, allowedWebSocket := hcmServesGRPC(mgr), hcmAllowsWebSocket(mgr)
+		if err := t.patchHCMWithListenerFeatures(mgr, irListener); err != nil {
+			return false, false, err
+		}
+		if err := patchRoutesWithProtocolFeatures(xdsRouteCfg,
+			!servedGRPC && hcmServesGRPC(mgr), !allowedWebSocket && hcmAllowsWebSocket(mgr)); err != nil {
+			return false, false, err
+		}
+
+		mgr.HttpFilters = append(mgr.HttpFilters, router)
+		mgrAny, err := protocov.ToAnyWithError(mgr)
+		if err != nil {
+			return false, false, err
+		}
+		filter.ConfigType = &listenerv3.Filter_TypedConfig{
+			TypedConfig: mgrAny,
+		}
+		return hcmServesGRPC(mgr), hcmAllowsWebSocket(mgr), nil
+	}
+
+	return false, false, errors.New("unable to find the hcm of the default filter chain")
+}
+
+func addXdsTCPFilterChain(xdsListener *listenerv3.Listener, irListener *ir.TCPListener) error {
 	if irListener == nil {
 		return errors.New("tcp listener is nil")
 	}
@@ -234,12 +307,11 @@ func addXdsTCPFilterChain(xdsListener *listenerv3.Listener, irListener *ir.TCPLi
 	}
 
 	mgr := &tcpv3.TcpProxy{
-		AccessLog:  buildXdsAccessLog(accesslog, false),
+		AccessLog:  buildXdsAccessLog(irListener.AccessLog, accessLogKindProxy),
 		StatPrefix: statPrefix,
-		ClusterSpecifier: &tcpv3.TcpProxy_Cluster{
-			Cluster: clusterName,
-		},
 	}
+	setXdsTCPProxyClusterSpecifier(mgr, irListener)
+	setXdsTCPProxySettings(mgr, irListener.ProxySettings)
 	mgrAny, err := anypb.New(mgr)
 	if err != nil {
 		return err
@@ -273,6 +345,56 @@ func addXdsTCPFilterChain(xdsListener *listenerv3.Listener, irListener *ir.TCPLi
 	return nil
 }
 
+// setXdsTCPProxyClusterSpecifier sets the cluster of the tcp proxy of a TCP listener,
+// or the weighted clusters if the listener has weighted destinations.
+func setXdsTCPProxyClusterSpecifier(mgr *tcpv3.TcpProxy, irListener *ir.TCPListener) {
+	if irListener.Destination != nil {
+		mgr.ClusterSpecifier = &tcpv3.TcpProxy_Cluster{
+			Cluster: irListener.Destination.Name,
+		}
+		return
+	}
+
+	clusters := make([]*tcpv3.TcpProxy_WeightedCluster_ClusterWeight, 0, len(irListener.WeightedDestinations))
+	for _, weighted := range irListener.WeightedDestinations {
+		clusters = append(clusters, &tcpv3.TcpProxy_WeightedCluster_ClusterWeight{
+			Name:   weighted.Destination.Name,
+			Weight: weighted.Weight,
+		})
+	}
+	mgr.ClusterSpecifier = &tcpv3.TcpProxy_WeightedClusters{
+		WeightedClusters: &tcpv3.TcpProxy_WeightedCluster{
+			Clusters: clusters,
+		},
+	}
+}
+
+// setXdsTCPProxySettings sets the timeouts, connect attempts and access log
+// options of the tcp proxy of a TCP listener.
+func setXdsTCPProxySettings(mgr *tcpv3.TcpProxy, settings *ir.TCPProxySettings) {
+	if settings == nil {
+		return
+	}
+
+	if settings.IdleTimeout != nil {
+		mgr.IdleTimeout = durationpb.New(settings.IdleTimeout.Duration)
+	}
+	if settings.MaxConnectionDuration != nil {
+		mgr.MaxDownstreamConnectionDuration = durationpb.New(settings.MaxConnectionDuration.Duration)
+	}
+	if settings.MaxConnectAttempts != nil {
+		mgr.MaxConnectAttempts = wrapperspb.UInt32(*settings.MaxConnectAttempts)
+	}
+	if settings.AccessLogFlushInterval != nil || settings.FlushAccessLogOnConnected {
+		mgr.AccessLogOptions = &tcpv3.TcpProxy_TcpAccessLogOptions{
+			FlushAccessLogOnConnected: settings.FlushAccessLogOnConnected,
+		}
+		if settings.AccessLogFlushInterval != nil {
+			mgr.AccessLogOptions.AccessLogFlushInterval = durationpb.New(settings.AccessLogFlushInterval.Duration)
+		}
+	}
+}
+
 // addXdsTLSInspectorFilter adds a Tls Inspector filter if it does not yet exist.
 func addXdsTLSInspectorFilter(xdsListener *listenerv3.Listener) error {
 	// Return early if it exists
@@ -347,7 +469,7 @@ func buildXdsDownstreamTLSSecret(tlsConfig *ir.TLSListenerConfig) *tlsv3.Secret
 	}
 }
 
-func buildXdsUDPListener(clusterName string, udpListener *ir.UDPListener, accesslog *ir.AccessLog) (*listenerv3.Listener, error) {
+func buildXdsUDPListener(clusterName string, udpListener *ir.UDPListener) (*listenerv3.Listener, error) {
 	if udpListener == nil {
 		return nil, errors.New("udp listener is nil")
 	}
@@ -363,8 +485,9 @@ func buildXdsUDPListener(clusterName string, udpListener *ir.UDPListener, access
 	}
 
 	udpProxy := &udpv3.UdpProxyConfig{
-		StatPrefix: statPrefix,
-		AccessLog:  buildXdsAccessLog(accesslog, false),
+		StatPrefix:  statPrefix,
+		AccessLog:   buildXdsAccessLog(udpListener.AccessLog, accessLogKindProxy),
+		IdleTimeout: buildXdsUDPSessionIdleTimeout(udpListener.ProxySettings),
 		RouteSpecifier: &udpv3.UdpProxyConfig_Matcher{
 			Matcher: &matcher.Matcher{
 				OnNoMatch: &matcher.Matcher_OnMatch{
@@ -385,7 +508,7 @@ func buildXdsUDPListener(clusterName string, udpListener *ir.UDPListener, access
 
 	xdsListener := &listenerv3.Listener{
 		Name:      udpListener.Name,
-		AccessLog: buildXdsAccessLog(accesslog, true),
+		AccessLog: buildXdsAccessLog(udpListener.AccessLog, accessLogKindListener),
 		Address: &corev3.Address{
 			Address: &corev3.Address_SocketAddress{
 				SocketAddress: &corev3.SocketAddress{
@@ -408,6 +531,15 @@ func buildXdsUDPListener(clusterName string, udpListener *ir.UDPListener, access
 	return xdsListener, nil
 }
 
+// buildXdsUDPSessionIdleTimeout returns the session idle timeout of a UDP listener,
+// or nil to use the Envoy default.
+func buildXdsUDPSessionIdleTimeout(settings *ir.UDPProxySettings) *durationpb.Duration {
+	if settings == nil || settings.SessionIdleTimeout == nil {
+		return nil
+	}
+	return durationpb.New(settings.SessionIdleTimeout.Duration)
+}
+
 // Point to xds cluster.
 func makeConfigSource() *corev3.ConfigSource {
 	source := &corev3.ConfigSource{}
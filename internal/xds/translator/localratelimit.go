@@ -0,0 +1,252 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	ratelimitv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/ratelimit/v3"
+	localratelimitv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/local_ratelimit/v3"
+	hcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	rlsconfv3 "github.com/envoyproxy/go-control-plane/ratelimit/config/ratelimit/v3"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+const (
+	localRateLimitFilter             = "envoy.filters.http.local_ratelimit"
+	localRateLimitFilterStatPrefix   = "http_local_rate_limiter"
+	localRateLimitFilterEnabledKey   = "local_rate_limit_enabled"
+	localRateLimitFilterEnforcedKey  = "local_rate_limit_enforced"
+	localRateLimitDescriptorMaskedIP = "masked_remote_address"
+)
+
+// patchHCMWithLocalRateLimitFilter adds the local rate limit filter to the http
+// connection manager, if the listener has routes with local rate limits.
+// The filter is configured without a token bucket, so it is disabled unless a
+// route enables it with its per route config.
+func patchHCMWithLocalRateLimitFilter(mgr *hcmv3.HttpConnectionManager, irListener *ir.HTTPListener) error {
+	if mgr == nil {
+		return errors.New("hcm is nil")
+	}
+
+	if irListener == nil {
+		return errors.New("ir listener is nil")
+	}
+
+	if !listenerContainsLocalRateLimit(irListener) {
+		return nil
+	}
+
+	// Return early if filter already exists.
+	for _, httpFilter := range mgr.HttpFilters {
+		if httpFilter.Name == localRateLimitFilter {
+			return nil
+		}
+	}
+
+	localRateLimitAny, err := anypb.New(&localratelimitv3.LocalRateLimit{
+		StatPrefix: localRateLimitFilterStatPrefix,
+	})
+	if err != nil {
+		return err
+	}
+
+	mgr.HttpFilters = append(mgr.HttpFilters, &hcmv3.HttpFilter{
+		Name: localRateLimitFilter,
+		ConfigType: &hcmv3.HttpFilter_TypedConfig{
+			TypedConfig: localRateLimitAny,
+		},
+	})
+
+	return nil
+}
+
+// listenerContainsLocalRateLimit returns true if the provided listener has
+// routes with local rate limits.
+func listenerContainsLocalRateLimit(irListener *ir.HTTPListener) bool {
+	for _, route := range irListener.Routes {
+		if routeContainsLocalRateLimit(route) {
+			return true
+		}
+	}
+	return false
+}
+
+// routeContainsLocalRateLimit returns true if the provided route has local rate limits.
+func routeContainsLocalRateLimit(irRoute *ir.HTTPRoute) bool {
+	return irRoute != nil && irRoute.RateLimit != nil && irRoute.RateLimit.Local != nil
+}
+
+// patchRouteWithLocalRateLimit enables the local rate limit filter on the route,
+// if the route has local rate limits. Each rule of the route is translated to
+// a rate limit action of the route, and to a descriptor with the token bucket
+// of the rule in the per route config of the filter.
+func patchRouteWithLocalRateLimit(route *routev3.Route, irRoute *ir.HTTPRoute) error {
+	if route == nil {
+		return errors.New("xds route is nil")
+	}
+	if irRoute == nil {
+		return errors.New("ir route is nil")
+	}
+	if !routeContainsLocalRateLimit(irRoute) {
+		return nil
+	}
+
+	// The rate limit actions are set on the route action, so there is nothing
+	// to limit for the direct responses and the redirects.
+	routeAction := route.GetRoute()
+	if routeAction == nil {
+		return nil
+	}
+
+	local := irRoute.RateLimit.Local
+	routeAction.RateLimits = buildRouteRateLimits(irRoute.Name, local.Rules, routeJWTIssuers(irRoute))
+
+	descriptors := make([]*ratelimitv3.LocalRateLimitDescriptor, 0, len(local.Rules))
+	for rIdx, rule := range local.Rules {
+		tokenBucket, err := buildLocalRateLimitTokenBucket(rule)
+		if err != nil {
+			return err
+		}
+		descriptors = append(descriptors, &ratelimitv3.LocalRateLimitDescriptor{
+			Entries:     buildLocalRateLimitDescriptorEntries(irRoute.Name, rIdx, rule),
+			TokenBucket: tokenBucket,
+		})
+	}
+
+	routeCfgAny, err := anypb.New(&localratelimitv3.LocalRateLimit{
+		StatPrefix: localRateLimitFilterStatPrefix,
+		// The token bucket of the route applies to the requests that don't match
+		// any descriptor, so it must never limit the requests.
+		TokenBucket: &typev3.TokenBucket{
+			MaxTokens:     math.MaxUint32,
+			TokensPerFill: wrapperspb.UInt32(math.MaxUint32),
+			FillInterval:  durationpb.New(time.Second),
+		},
+		FilterEnabled: &corev3.RuntimeFractionalPercent{
+			DefaultValue: &typev3.FractionalPercent{
+				Numerator:   100,
+				Denominator: typev3.FractionalPercent_HUNDRED,
+			},
+			RuntimeKey: localRateLimitFilterEnabledKey,
+		},
+		FilterEnforced: &corev3.RuntimeFractionalPercent{
+			DefaultValue: &typev3.FractionalPercent{
+				Numerator:   100,
+				Denominator: typev3.FractionalPercent_HUNDRED,
+			},
+			RuntimeKey: localRateLimitFilterEnforcedKey,
+		},
+		Descriptors: descriptors,
+		// Return the quota of the client in the X-RateLimit headers, like the
+		// global rate limits.
+		EnableXRatelimitHeaders: ratelimitv3.XRateLimitHeadersRFCVersion(xRateLimitHeadersRfcVersion),
+	})
+	if err != nil {
+		return err
+	}
+
+	if route.TypedPerFilterConfig == nil {
+		route.TypedPerFilterConfig = make(map[string]*anypb.Any)
+	}
+	route.TypedPerFilterConfig[localRateLimitFilter] = routeCfgAny
+
+	return nil
+}
+
+// buildLocalRateLimitDescriptorEntries returns the entries of the descriptor
+// generated by the rate limit actions of the rule, in the order of the actions
+// built by buildRouteRateLimits.
+func buildLocalRateLimitDescriptorEntries(descriptorPrefix string, rIdx int, rule *ir.RateLimitRule) []*ratelimitv3.RateLimitDescriptor_Entry {
+	entries := []*ratelimitv3.RateLimitDescriptor_Entry{}
+	for mIdx := range rule.HeaderMatches {
+		entries = append(entries, &ratelimitv3.RateLimitDescriptor_Entry{
+			Key:   getRateLimitDescriptorKey(descriptorPrefix, rIdx, mIdx),
+			Value: getRateLimitDescriptorValue(descriptorPrefix, rIdx, mIdx),
+		})
+	}
+
+	for qIdx := range rule.QueryParamMatches {
+		mIdx := len(rule.HeaderMatches) + qIdx
+		entries = append(entries, &ratelimitv3.RateLimitDescriptor_Entry{
+			Key:   getRateLimitDescriptorKey(descriptorPrefix, rIdx, mIdx),
+			Value: getRateLimitDescriptorValue(descriptorPrefix, rIdx, mIdx),
+		})
+	}
+
+	// The entries of the claims hold the value of the claim, since only the
+	// Exact matches are supported by the local rate limits.
+	for cIdx, match := range rule.JWTClaimMatches {
+		entry := &ratelimitv3.RateLimitDescriptor_Entry{
+			Key: getRateLimitDescriptorKey(descriptorPrefix, rIdx, len(rule.HeaderMatches)+len(rule.QueryParamMatches)+cIdx),
+		}
+		if match.Exact != nil {
+			entry.Value = *match.Exact
+		}
+		entries = append(entries, entry)
+	}
+
+	if rule.CIDRMatch != nil {
+		entries = append(entries, &ratelimitv3.RateLimitDescriptor_Entry{
+			Key:   localRateLimitDescriptorMaskedIP,
+			Value: rule.CIDRMatch.CIDR,
+		})
+	}
+
+	if !rule.IsMatchSet() {
+		entries = append(entries, &ratelimitv3.RateLimitDescriptor_Entry{
+			Key:   getRateLimitDescriptorKey(descriptorPrefix, rIdx, -1),
+			Value: getRateLimitDescriptorValue(descriptorPrefix, rIdx, -1),
+		})
+	}
+
+	return entries
+}
+
+// buildLocalRateLimitTokenBucket returns a token bucket allowing the requests
+// of the limit of the rule per unit, multiplied by the unit multiplier.
+func buildLocalRateLimitTokenBucket(rule *ir.RateLimitRule) (*typev3.TokenBucket, error) {
+	requests := rateLimitRequestsPerUnit(rule)
+	fillInterval := rateLimitUnitToDuration(rule.Limit.Unit)
+	if rule.Limit.UnitMultiplier > 1 {
+		if time.Duration(rule.Limit.UnitMultiplier) > math.MaxInt64/fillInterval {
+			return nil, fmt.Errorf("unit multiplier %d of the %s unit overflows the fill interval",
+				rule.Limit.UnitMultiplier, rule.Limit.Unit)
+		}
+		fillInterval *= time.Duration(rule.Limit.UnitMultiplier)
+	}
+
+	return &typev3.TokenBucket{
+		MaxTokens:     requests,
+		TokensPerFill: wrapperspb.UInt32(requests),
+		FillInterval:  durationpb.New(fillInterval),
+	}, nil
+}
+
+// rateLimitUnitToDuration returns the duration of the rate limit unit.
+func rateLimitUnitToDuration(unit ir.RateLimitUnit) time.Duration {
+	switch rlsconfv3.RateLimitUnit(rlsconfv3.RateLimitUnit_value[strings.ToUpper(string(unit))]) {
+	case rlsconfv3.RateLimitUnit_MINUTE:
+		return time.Minute
+	case rlsconfv3.RateLimitUnit_HOUR:
+		return time.Hour
+	case rlsconfv3.RateLimitUnit_DAY:
+		return 24 * time.Hour
+	default:
+		return time.Second
+	}
+}
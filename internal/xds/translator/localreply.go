@@ -0,0 +1,116 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"fmt"
+
+	accesslog "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v3"
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	hcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+const (
+	// localReplyRuntimeKeyPrefix prefixes the runtime keys of the status code filters of the local reply mappers.
+	localReplyRuntimeKeyPrefix = "local_reply"
+	// localReplyBodyFormat keeps the (possibly rewritten) body of the local reply as is.
+	localReplyBodyFormat = "%LOCAL_REPLY_BODY%"
+)
+
+// buildXdsLocalReplyConfig builds the HCM local reply configuration from the IR LocalReply.
+func buildXdsLocalReplyConfig(localReply *ir.LocalReply) *hcmv3.LocalReplyConfig {
+	if localReply == nil || len(localReply.Mappers) == 0 {
+		return nil
+	}
+
+	config := &hcmv3.LocalReplyConfig{}
+	for _, mapper := range localReply.Mappers {
+		config.Mappers = append(config.Mappers, buildXdsResponseMapper(mapper))
+	}
+	return config
+}
+
+func buildXdsResponseMapper(mapper *ir.LocalReplyMapper) *hcmv3.ResponseMapper {
+	var filters []*accesslog.AccessLogFilter
+	for i, code := range mapper.StatusCodes {
+		filters = append(filters, &accesslog.AccessLogFilter{
+			FilterSpecifier: &accesslog.AccessLogFilter_StatusCodeFilter{
+				StatusCodeFilter: &accesslog.StatusCodeFilter{
+					Comparison: &accesslog.ComparisonFilter{
+						Op: accesslog.ComparisonFilter_EQ,
+						Value: &corev3.RuntimeUInt32{
+							DefaultValue: code,
+							RuntimeKey:   localReplyStatusCodeRuntimeKey(mapper, i),
+						},
+					},
+				},
+			},
+		})
+	}
+
+	filter := filters[0]
+	if len(filters) > 1 {
+		filter = &accesslog.AccessLogFilter{
+			FilterSpecifier: &accesslog.AccessLogFilter_OrFilter{
+				OrFilter: &accesslog.OrFilter{Filters: filters},
+			},
+		}
+	}
+
+	if len(mapper.ResponseFlags) > 0 {
+		filter = &accesslog.AccessLogFilter{
+			FilterSpecifier: &accesslog.AccessLogFilter_AndFilter{
+				AndFilter: &accesslog.AndFilter{
+					Filters: []*accesslog.AccessLogFilter{
+						filter,
+						{
+							FilterSpecifier: &accesslog.AccessLogFilter_ResponseFlagFilter{
+								ResponseFlagFilter: &accesslog.ResponseFlagFilter{Flags: mapper.ResponseFlags},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	responseMapper := &hcmv3.ResponseMapper{
+		Filter: filter,
+	}
+	if mapper.StatusCode != nil {
+		responseMapper.StatusCode = wrapperspb.UInt32(*mapper.StatusCode)
+	}
+	if mapper.Body != nil {
+		responseMapper.Body = &corev3.DataSource{
+			Specifier: &corev3.DataSource_InlineString{
+				InlineString: *mapper.Body,
+			},
+		}
+	}
+	if mapper.ContentType != nil {
+		responseMapper.BodyFormatOverride = &corev3.SubstitutionFormatString{
+			Format: &corev3.SubstitutionFormatString_TextFormatSource{
+				TextFormatSource: &corev3.DataSource{
+					Specifier: &corev3.DataSource_InlineString{
+						InlineString: localReplyBodyFormat,
+					},
+				},
+			},
+			ContentType: *mapper.ContentType,
+		}
+	}
+
+	return responseMapper
+}
+
+// localReplyStatusCodeRuntimeKey returns the runtime key of the status code filter
+// of the mapper at the given index, so that each filter can be overridden on its own.
+func localReplyStatusCodeRuntimeKey(mapper *ir.LocalReplyMapper, index int) string {
+	return fmt.Sprintf("%s.%s.status_code.%d", localReplyRuntimeKeyPrefix, mapper.Name, index)
+}
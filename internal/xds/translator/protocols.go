@@ -0,0 +1,200 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"errors"
+
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	hcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/envoyproxy/gateway/internal/ir"
+	xdsfilters "github.com/envoyproxy/gateway/internal/xds/filters"
+)
+
+const webSocketUpgradeType = "websocket"
+
+// patchHCMWithProtocolFeatures enables the gRPC filters and the WebSocket upgrades
+// on the http connection manager, if the routes of the listener need them.
+// The routes that don't use these features disable them with a per route config.
+func patchHCMWithProtocolFeatures(mgr *hcmv3.HttpConnectionManager, irListener *ir.HTTPListener) {
+	if listenerServesGRPC(irListener) && !hcmServesGRPC(mgr) {
+		mgr.HttpFilters = append(mgr.HttpFilters, xdsfilters.GRPCWeb)
+		// always enable grpc stats filter
+		mgr.HttpFilters = append(mgr.HttpFilters, xdsfilters.GRPCStats)
+	}
+
+	if listenerAllowsWebSocket(irListener) && !hcmAllowsWebSocket(mgr) {
+		// Allow websocket upgrades for HTTP 1.1
+		// Reference: https://developer.mozilla.org/en-US/docs/Web/HTTP/Protocol_upgrade_mechanism
+		mgr.UpgradeConfigs = []*hcmv3.HttpConnectionManager_UpgradeConfig{
+			{
+				UpgradeType: webSocketUpgradeType,
+			},
+		}
+	}
+}
+
+// hcmServesGRPC returns true if the gRPC filters are enabled on the http connection manager.
+func hcmServesGRPC(mgr *hcmv3.HttpConnectionManager) bool {
+	for _, httpFilter := range mgr.HttpFilters {
+		if httpFilter.Name == wellknown.GRPCWeb {
+			return true
+		}
+	}
+	return false
+}
+
+// hcmAllowsWebSocket returns true if the WebSocket upgrades are enabled on the http connection manager.
+func hcmAllowsWebSocket(mgr *hcmv3.HttpConnectionManager) bool {
+	for _, upgradeConfig := range mgr.UpgradeConfigs {
+		if upgradeConfig.UpgradeType == webSocketUpgradeType {
+			return true
+		}
+	}
+	return false
+}
+
+// patchRoutesWithProtocolFeatures disables the gRPC filters and the WebSocket upgrades
+// for all the routes of the route config, e.g. when a listener joining the http
+// connection manager shared by these routes enables them.
+func patchRoutesWithProtocolFeatures(routeCfg *routev3.RouteConfiguration, disableGRPC, disableWebSocket bool) error {
+	if routeCfg == nil {
+		return nil
+	}
+
+	for _, vHost := range routeCfg.VirtualHosts {
+		for _, route := range vHost.Routes {
+			if disableGRPC {
+				if err := disableRouteFilter(route, wellknown.GRPCWeb); err != nil {
+					return err
+				}
+				if err := disableRouteFilter(route, wellknown.HTTPGRPCStats); err != nil {
+					return err
+				}
+			}
+			if routeAction := route.GetRoute(); routeAction != nil && disableWebSocket {
+				routeAction.UpgradeConfigs = []*routev3.RouteAction_UpgradeConfig{
+					{
+						UpgradeType: webSocketUpgradeType,
+						Enabled:     wrapperspb.Bool(false),
+					},
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// patchRouteWithProtocolFeatures disables the gRPC filters and the WebSocket upgrades
+// enabled on the http connection manager of the listener for the route, if the route
+// doesn't use them.
+func patchRouteWithProtocolFeatures(route *routev3.Route, irRoute *ir.HTTPRoute, servesGRPC, allowsWebSocket bool) error {
+	if route == nil {
+		return errors.New("xds route is nil")
+	}
+	if irRoute == nil {
+		return errors.New("ir route is nil")
+	}
+
+	if servesGRPC {
+		if irRoute.GRPC == nil || irRoute.GRPC.DisableWeb {
+			if err := disableRouteFilter(route, wellknown.GRPCWeb); err != nil {
+				return err
+			}
+		}
+		if irRoute.GRPC == nil || irRoute.GRPC.DisableStats {
+			if err := disableRouteFilter(route, wellknown.HTTPGRPCStats); err != nil {
+				return err
+			}
+		}
+	}
+
+	if routeAction := route.GetRoute(); routeAction != nil &&
+		allowsWebSocket && !routeAllowsWebSocket(irRoute) {
+		routeAction.UpgradeConfigs = []*routev3.RouteAction_UpgradeConfig{
+			{
+				UpgradeType: webSocketUpgradeType,
+				Enabled:     wrapperspb.Bool(false),
+			},
+		}
+	}
+
+	return nil
+}
+
+// disableRouteFilter disables the http filter with the given name for the route.
+func disableRouteFilter(route *routev3.Route, filterName string) error {
+	filterCfgAny, err := anypb.New(&routev3.FilterConfig{Disabled: true})
+	if err != nil {
+		return err
+	}
+
+	if route.TypedPerFilterConfig == nil {
+		route.TypedPerFilterConfig = make(map[string]*anypb.Any)
+	}
+	route.TypedPerFilterConfig[filterName] = filterCfgAny
+
+	return nil
+}
+
+// listenerServesGRPC returns true if the listener has gRPC routes.
+func listenerServesGRPC(irListener *ir.HTTPListener) bool {
+	if irListener.IsHTTP2 {
+		return true
+	}
+	for _, route := range irListener.Routes {
+		if route.GRPC != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// listenerAllowsWebSocket returns true if the WebSocket upgrades must be enabled on the listener,
+// i.e. the listener doesn't serve gRPC routes only.
+func listenerAllowsWebSocket(irListener *ir.HTTPListener) bool {
+	if !listenerServesGRPC(irListener) {
+		return true
+	}
+	for _, route := range irListener.Routes {
+		if routeAllowsWebSocket(route) {
+			return true
+		}
+	}
+	return false
+}
+
+// routeAllowsWebSocket returns true if the WebSocket upgrades are enabled for the route.
+func routeAllowsWebSocket(irRoute *ir.HTTPRoute) bool {
+	return irRoute.GRPC == nil && !irRoute.DisableWebSocket
+}
+
+// upstreamProtocol returns the protocol used to forward the requests of the route to the endpoints.
+// gRPC traffic is always forwarded over HTTP2. Other traffic is forwarded over HTTP2 if all the
+// endpoints speak HTTP2, e.g. as declared by the appProtocol of their Service port, and over
+// HTTP/1.1 otherwise.
+func upstreamProtocol(irRoute *ir.HTTPRoute, endpoints []*ir.DestinationEndpoint) ProtocolType {
+	if irRoute.GRPC != nil {
+		return HTTP2
+	}
+	if len(endpoints) == 0 {
+		return DefaultProtocol
+	}
+	for _, ep := range endpoints {
+		if ep.Protocol != ir.AppProtocolHTTP2 {
+			return DefaultProtocol
+		}
+	}
+	if routeAllowsWebSocket(irRoute) {
+		return HTTP2WebSocket
+	}
+	return HTTP2
+}
@@ -18,9 +18,12 @@ import (
 	ratelimitfilterv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ratelimit/v3"
 	hcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	tlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	matcherv3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	metadatav3 "github.com/envoyproxy/go-control-plane/envoy/type/metadata/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	rlsconfv3 "github.com/envoyproxy/go-control-plane/ratelimit/config/ratelimit/v3"
 	"github.com/envoyproxy/ratelimit/src/config"
+	"golang.org/x/exp/slices"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
@@ -89,17 +92,8 @@ func (t *Translator) isRateLimitPresent(irListener *ir.HTTPListener) bool {
 func (t *Translator) buildRateLimitFilter(irListener *ir.HTTPListener) *hcmv3.HttpFilter {
 
 	rateLimitFilterProto := &ratelimitfilterv3.RateLimit{
-		Domain: getRateLimitDomain(irListener),
-		RateLimitService: &ratelimitv3.RateLimitServiceConfig{
-			GrpcService: &corev3.GrpcService{
-				TargetSpecifier: &corev3.GrpcService_EnvoyGrpc_{
-					EnvoyGrpc: &corev3.GrpcService_EnvoyGrpc{
-						ClusterName: getRateLimitServiceClusterName(),
-					},
-				},
-			},
-			TransportApiVersion: corev3.ApiVersion_V3,
-		},
+		Domain:                  getRateLimitDomain(irListener),
+		RateLimitService:        buildRateLimitServiceConfig(),
 		EnableXRatelimitHeaders: ratelimitfilterv3.RateLimit_XRateLimitHeadersRFCVersion(xRateLimitHeadersRfcVersion),
 	}
 	if t.GlobalRateLimit.Timeout > 0 {
@@ -124,6 +118,21 @@ func (t *Translator) buildRateLimitFilter(irListener *ir.HTTPListener) *hcmv3.Ht
 	return rateLimitFilter
 }
 
+// buildRateLimitServiceConfig returns the config of the grpc service of the
+// rate limit service, used by the http and network rate limit filters.
+func buildRateLimitServiceConfig() *ratelimitv3.RateLimitServiceConfig {
+	return &ratelimitv3.RateLimitServiceConfig{
+		GrpcService: &corev3.GrpcService{
+			TargetSpecifier: &corev3.GrpcService_EnvoyGrpc_{
+				EnvoyGrpc: &corev3.GrpcService_EnvoyGrpc{
+					ClusterName: getRateLimitServiceClusterName(),
+				},
+			},
+		},
+		TransportApiVersion: corev3.ApiVersion_V3,
+	}
+}
+
 // patchRouteWithRateLimit builds rate limit actions and appends to the route.
 func patchRouteWithRateLimit(xdsRouteAction *routev3.RouteAction, irRoute *ir.HTTPRoute) error { //nolint:unparam
 	// Return early if no rate limit config exists.
@@ -131,15 +140,15 @@ func patchRouteWithRateLimit(xdsRouteAction *routev3.RouteAction, irRoute *ir.HT
 		return nil
 	}
 
-	rateLimits := buildRouteRateLimits(irRoute.Name, irRoute.RateLimit.Global)
+	rateLimits := buildRouteRateLimits(irRoute.Name, irRoute.RateLimit.Global.Rules, routeJWTIssuers(irRoute))
 	xdsRouteAction.RateLimits = rateLimits
 	return nil
 }
 
-func buildRouteRateLimits(descriptorPrefix string, global *ir.GlobalRateLimit) []*routev3.RateLimit {
+func buildRouteRateLimits(descriptorPrefix string, rules []*ir.RateLimitRule, jwtIssuers []string) []*routev3.RateLimit {
 	rateLimits := []*routev3.RateLimit{}
 	// Rules are ORed
-	for rIdx, rule := range global.Rules {
+	for rIdx, rule := range rules {
 		rlActions := []*routev3.RateLimit_Action{}
 		// Matches are ANDed
 		for mIdx, match := range rule.HeaderMatches {
@@ -182,6 +191,53 @@ func buildRouteRateLimits(descriptorPrefix string, global *ir.GlobalRateLimit) [
 			}
 		}
 
+		// The query parameter matches are numbered after the header matches.
+		for qIdx, match := range rule.QueryParamMatches {
+			descriptorKey := getRateLimitDescriptorKey(descriptorPrefix, rIdx, len(rule.HeaderMatches)+qIdx)
+			var action *routev3.RateLimit_Action
+			if match.Distinct {
+				// Setup an Extension action with the query parameter input, since
+				// there is no action for the value of a query parameter.
+				queryParamInputAny, _ := anypb.New(&matcherv3.HttpRequestQueryParamMatchInput{
+					QueryParam: match.Name,
+				})
+				action = &routev3.RateLimit_Action{
+					ActionSpecifier: &routev3.RateLimit_Action_Extension{
+						Extension: &corev3.TypedExtensionConfig{
+							Name:        descriptorKey,
+							TypedConfig: queryParamInputAny,
+						},
+					},
+				}
+			} else {
+				// Setup QueryParameterValueMatch actions
+				descriptorVal := getRateLimitDescriptorValue(descriptorPrefix, rIdx, len(rule.HeaderMatches)+qIdx)
+				action = &routev3.RateLimit_Action{
+					ActionSpecifier: &routev3.RateLimit_Action_QueryParameterValueMatch_{
+						QueryParameterValueMatch: &routev3.RateLimit_Action_QueryParameterValueMatch{
+							DescriptorKey:   descriptorKey,
+							DescriptorValue: descriptorVal,
+							ExpectMatch: &wrapperspb.BoolValue{
+								Value: true,
+							},
+							QueryParameters: []*routev3.QueryParameterMatcher{
+								{
+									Name: match.Name,
+									QueryParameterMatchSpecifier: &routev3.QueryParameterMatcher_StringMatch{
+										StringMatch: buildXdsStringMatcher(match),
+									},
+								},
+							},
+						},
+					},
+				}
+			}
+			rlActions = append(rlActions, action)
+		}
+
+		// The claim actions are inserted after the header and query parameter actions.
+		claimActionsIdx := len(rlActions)
+
 		// To be able to rate limit each individual IP, we need to use a nested descriptors structure in the configuration
 		// of the rate limit server:
 		// * the outer layer is a masked_remote_address descriptor that catches all the source IPs inside a specified CIDR.
@@ -242,13 +298,78 @@ func buildRouteRateLimits(descriptorPrefix string, global *ir.GlobalRateLimit) [
 			rlActions = append(rlActions, action)
 		}
 
-		rateLimit := &routev3.RateLimit{Actions: rlActions}
-		rateLimits = append(rateLimits, rateLimit)
+		if len(rule.JWTClaimMatches) == 0 {
+			rateLimit := &routev3.RateLimit{Actions: rlActions}
+			rateLimits = append(rateLimits, rateLimit)
+			continue
+		}
+
+		// The payload of the JWT is stored in the dynamic metadata of the jwt authn
+		// filter under the issuer of the provider that validated it, so the rule is
+		// repeated for each issuer. Only the rate limit of the issuer of the JWT of
+		// the request generates a descriptor, since the metadata of the other issuers
+		// is missing. The descriptor holds the value of the claim, which is matched by
+		// the rate limit service for the Exact matches.
+		for _, issuer := range jwtIssuers {
+			actions := make([]*routev3.RateLimit_Action, 0, len(rlActions)+len(rule.JWTClaimMatches))
+			actions = append(actions, rlActions[:claimActionsIdx]...)
+			actions = append(actions, buildJWTClaimRateLimitActions(descriptorPrefix, rIdx, rule, issuer)...)
+			actions = append(actions, rlActions[claimActionsIdx:]...)
+			rateLimits = append(rateLimits, &routev3.RateLimit{Actions: actions})
+		}
 	}
 
 	return rateLimits
 }
 
+// buildJWTClaimRateLimitActions returns the rate limit actions reading the claims of the rule
+// from the payload of the JWT validated by the provider with the issuer.
+func buildJWTClaimRateLimitActions(descriptorPrefix string, rIdx int, rule *ir.RateLimitRule, issuer string) []*routev3.RateLimit_Action {
+	actions := make([]*routev3.RateLimit_Action, 0, len(rule.JWTClaimMatches))
+	for cIdx, match := range rule.JWTClaimMatches {
+		actions = append(actions, &routev3.RateLimit_Action{
+			ActionSpecifier: &routev3.RateLimit_Action_Metadata{
+				Metadata: &routev3.RateLimit_Action_MetaData{
+					DescriptorKey: getRateLimitDescriptorKey(descriptorPrefix, rIdx, len(rule.HeaderMatches)+len(rule.QueryParamMatches)+cIdx),
+					MetadataKey: &metadatav3.MetadataKey{
+						Key: jwtAuthenFilter,
+						Path: []*metadatav3.MetadataKey_PathSegment{
+							{
+								Segment: &metadatav3.MetadataKey_PathSegment_Key{
+									Key: issuer,
+								},
+							},
+							{
+								Segment: &metadatav3.MetadataKey_PathSegment_Key{
+									Key: match.Name,
+								},
+							},
+						},
+					},
+					Source: routev3.RateLimit_Action_MetaData_DYNAMIC,
+				},
+			},
+		})
+	}
+	return actions
+}
+
+// routeJWTIssuers returns the issuers of the JWT providers of the route, whose payloads
+// are stored in the dynamic metadata. The JWTs of the providers without an issuer are not
+// stored in the metadata.
+func routeJWTIssuers(irRoute *ir.HTTPRoute) []string {
+	if irRoute.RequestAuthentication == nil || irRoute.RequestAuthentication.JWT == nil {
+		return nil
+	}
+	var issuers []string
+	for _, provider := range irRoute.RequestAuthentication.JWT.Providers {
+		if provider.Issuer != "" && !slices.Contains(issuers, provider.Issuer) {
+			issuers = append(issuers, provider.Issuer)
+		}
+	}
+	return issuers
+}
+
 // GetRateLimitServiceConfigStr returns the PB string for the rate limit service configuration.
 func GetRateLimitServiceConfigStr(pbCfg *rlsconfv3.RateLimitConfig) (string, error) {
 	var buf bytes.Buffer
@@ -287,20 +408,10 @@ func buildRateLimitServiceDescriptors(descriptorPrefix string, global *ir.Global
 	pbDescriptors := make([]*rlsconfv3.RateLimitDescriptor, 0, 1)
 
 	for rIdx, rule := range global.Rules {
-		var head, cur *rlsconfv3.RateLimitDescriptor
-		if !rule.IsMatchSet() {
-			pbDesc := new(rlsconfv3.RateLimitDescriptor)
-			// GenericKey case
-			pbDesc.Key = getRateLimitDescriptorKey(descriptorPrefix, rIdx, -1)
-			pbDesc.Value = getRateLimitDescriptorValue(descriptorPrefix, rIdx, -1)
-			rateLimit := rlsconfv3.RateLimitPolicy{
-				RequestsPerUnit: uint32(rule.Limit.Requests),
-				Unit:            rlsconfv3.RateLimitUnit(rlsconfv3.RateLimitUnit_value[strings.ToUpper(string(rule.Limit.Unit))]),
-			}
-			pbDesc.RateLimit = &rateLimit
-			head = pbDesc
-			cur = head
-		}
+		// The descriptors of the rule are nested in the order of the rate limit
+		// actions built by buildRouteRateLimits, and the limit is set on the
+		// innermost descriptor.
+		descs := make([]*rlsconfv3.RateLimitDescriptor, 0, 1)
 
 		for mIdx, match := range rule.HeaderMatches {
 			pbDesc := new(rlsconfv3.RateLimitDescriptor)
@@ -313,23 +424,32 @@ func buildRateLimitServiceDescriptors(descriptorPrefix string, global *ir.Global
 				pbDesc.Key = getRateLimitDescriptorKey(descriptorPrefix, rIdx, mIdx)
 				pbDesc.Value = getRateLimitDescriptorValue(descriptorPrefix, rIdx, mIdx)
 			}
+			descs = append(descs, pbDesc)
+		}
 
-			// Add the ratelimit values to the last descriptor
-			if mIdx == len(rule.HeaderMatches)-1 {
-				rateLimit := rlsconfv3.RateLimitPolicy{
-					RequestsPerUnit: uint32(rule.Limit.Requests),
-					Unit:            rlsconfv3.RateLimitUnit(rlsconfv3.RateLimitUnit_value[strings.ToUpper(string(rule.Limit.Unit))]),
-				}
-				pbDesc.RateLimit = &rateLimit
-			}
-
-			if mIdx == 0 {
-				head = pbDesc
+		for qIdx, match := range rule.QueryParamMatches {
+			mIdx := len(rule.HeaderMatches) + qIdx
+			pbDesc := new(rlsconfv3.RateLimitDescriptor)
+			// Case for distinct match
+			if match.Distinct {
+				// Extension case
+				pbDesc.Key = getRateLimitDescriptorKey(descriptorPrefix, rIdx, mIdx)
 			} else {
-				cur.Descriptors = []*rlsconfv3.RateLimitDescriptor{pbDesc}
+				// QueryParameterValueMatch case
+				pbDesc.Key = getRateLimitDescriptorKey(descriptorPrefix, rIdx, mIdx)
+				pbDesc.Value = getRateLimitDescriptorValue(descriptorPrefix, rIdx, mIdx)
 			}
+			descs = append(descs, pbDesc)
+		}
 
-			cur = pbDesc
+		for cIdx, match := range rule.JWTClaimMatches {
+			// Metadata case, the value of the claim is matched for the exact matches.
+			pbDesc := new(rlsconfv3.RateLimitDescriptor)
+			pbDesc.Key = getRateLimitDescriptorKey(descriptorPrefix, rIdx, len(rule.HeaderMatches)+len(rule.QueryParamMatches)+cIdx)
+			if !match.Distinct && match.Exact != nil {
+				pbDesc.Value = *match.Exact
+			}
+			descs = append(descs, pbDesc)
 		}
 
 		// EG supports two kinds of rate limit descriptors for the source IP: exact and distinct.
@@ -355,34 +475,56 @@ func buildRateLimitServiceDescriptors(descriptorPrefix string, global *ir.Global
 		// Please refer to [Rate Limit Service Descriptor list definition](https://github.com/envoyproxy/ratelimit#descriptor-list-definition) for details.
 		if rule.CIDRMatch != nil {
 			// MaskedRemoteAddress case
-			pbDesc := new(rlsconfv3.RateLimitDescriptor)
-			pbDesc.Key = "masked_remote_address"
-			pbDesc.Value = rule.CIDRMatch.CIDR
-			rateLimit := rlsconfv3.RateLimitPolicy{
-				RequestsPerUnit: uint32(rule.Limit.Requests),
-				Unit:            rlsconfv3.RateLimitUnit(rlsconfv3.RateLimitUnit_value[strings.ToUpper(string(rule.Limit.Unit))]),
-			}
-
+			descs = append(descs, &rlsconfv3.RateLimitDescriptor{
+				Key:   "masked_remote_address",
+				Value: rule.CIDRMatch.CIDR,
+			})
 			if rule.CIDRMatch.Distinct {
-				pbDesc.Descriptors = []*rlsconfv3.RateLimitDescriptor{
-					{
-						Key:       "remote_address",
-						RateLimit: &rateLimit,
-					},
-				}
-			} else {
-				pbDesc.RateLimit = &rateLimit
+				descs = append(descs, &rlsconfv3.RateLimitDescriptor{
+					Key: "remote_address",
+				})
 			}
-			head = pbDesc
-			cur = head
 		}
 
-		pbDescriptors = append(pbDescriptors, head)
+		if !rule.IsMatchSet() {
+			// GenericKey case
+			descs = append(descs, &rlsconfv3.RateLimitDescriptor{
+				Key:   getRateLimitDescriptorKey(descriptorPrefix, rIdx, -1),
+				Value: getRateLimitDescriptorValue(descriptorPrefix, rIdx, -1),
+			})
+		}
+
+		// Add the ratelimit values to the last descriptor. In shadow mode, the rate
+		// limit service reports the requests over the limit without limiting them.
+		descs[len(descs)-1].RateLimit = &rlsconfv3.RateLimitPolicy{
+			RequestsPerUnit: rateLimitRequestsPerUnit(rule),
+			Unit:            rlsconfv3.RateLimitUnit(rlsconfv3.RateLimitUnit_value[strings.ToUpper(string(rule.Limit.Unit))]),
+		}
+		descs[len(descs)-1].ShadowMode = rule.ShadowMode
+		for i := len(descs) - 1; i > 0; i-- {
+			descs[i-1].Descriptors = []*rlsconfv3.RateLimitDescriptor{descs[i]}
+		}
+
+		pbDescriptors = append(pbDescriptors, descs[0])
 	}
 
 	return pbDescriptors
 }
 
+// rateLimitRequestsPerUnit returns the number of requests of the rule allowed
+// per unit, taking the cost of the requests into account. The counters of the
+// rules are never shared, so counting each request as cost hits towards the
+// limit allows the same requests as a limit divided by the cost. The number of
+// requests of the limit is a multiple of the cost, as validated by the gatewayapi
+// translator, so the division is exact.
+func rateLimitRequestsPerUnit(rule *ir.RateLimitRule) uint32 {
+	requests := uint32(rule.Limit.Requests)
+	if rule.Cost > 1 {
+		requests /= rule.Cost
+	}
+	return requests
+}
+
 // buildRateLimitTLSocket builds the TLS socket for the rate limit service.
 func buildRateLimitTLSocket() (*corev3.TransportSocket, error) {
 	tlsCtx := &tlsv3.UpstreamTlsContext{
@@ -426,6 +568,12 @@ func (t *Translator) createRateLimitServiceCluster(tCtx *types.ResourceVersionTa
 	if !t.isRateLimitPresent(irListener) {
 		return nil
 	}
+	return t.addRateLimitServiceCluster(tCtx)
+}
+
+// addRateLimitServiceCluster adds the cluster of the rate limit service, if it
+// does not already exist.
+func (t *Translator) addRateLimitServiceCluster(tCtx *types.ResourceVersionTable) error {
 	clusterName := getRateLimitServiceClusterName()
 	// Create cluster if it does not exist
 	host, port := t.getRateLimitServiceGrpcHostPort()
@@ -6,11 +6,13 @@
 package translator
 
 import (
+	"math"
 	"strings"
 
 	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	matcherv3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	xdstype "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/envoyproxy/gateway/internal/ir"
@@ -43,8 +45,8 @@ func buildXdsRoute(httpRoute *ir.HTTPRoute) *routev3.Route {
 		router.Action = &routev3.Route_Redirect{Redirect: buildXdsRedirectAction(httpRoute.Redirect)}
 	case httpRoute.URLRewrite != nil:
 		routeAction := buildXdsURLRewriteAction(httpRoute.Destination.Name, httpRoute.URLRewrite)
-		if httpRoute.Mirror != nil {
-			routeAction.RequestMirrorPolicies = buildXdsRequestMirrorPolicies(httpRoute.Mirror.Name)
+		if len(httpRoute.Mirrors) > 0 {
+			routeAction.RequestMirrorPolicies = buildXdsRequestMirrorPolicies(httpRoute.Mirrors)
 		}
 
 		router.Action = &routev3.Route_Route{Route: routeAction}
@@ -52,14 +54,14 @@ func buildXdsRoute(httpRoute *ir.HTTPRoute) *routev3.Route {
 		if httpRoute.BackendWeights.Invalid != 0 {
 			// If there are invalid backends then a weighted cluster is required for the route
 			routeAction := buildXdsWeightedRouteAction(httpRoute)
-			if httpRoute.Mirror != nil {
-				routeAction.RequestMirrorPolicies = buildXdsRequestMirrorPolicies(httpRoute.Mirror.Name)
+			if len(httpRoute.Mirrors) > 0 {
+				routeAction.RequestMirrorPolicies = buildXdsRequestMirrorPolicies(httpRoute.Mirrors)
 			}
 			router.Action = &routev3.Route_Route{Route: routeAction}
 		} else {
 			routeAction := buildXdsRouteAction(httpRoute.Destination.Name)
-			if httpRoute.Mirror != nil {
-				routeAction.RequestMirrorPolicies = buildXdsRequestMirrorPolicies(httpRoute.Mirror.Name)
+			if len(httpRoute.Mirrors) > 0 {
+				routeAction.RequestMirrorPolicies = buildXdsRequestMirrorPolicies(httpRoute.Mirrors)
 			}
 			router.Action = &routev3.Route_Route{Route: routeAction}
 		}
@@ -230,6 +232,15 @@ func buildXdsRedirectAction(redirection *ir.Redirect) *routev3.RedirectAction {
 			routeAction.PathRewriteSpecifier = &routev3.RedirectAction_PrefixRewrite{
 				PrefixRewrite: *redirection.Path.PrefixMatchReplace,
 			}
+		} else if redirection.Path.RegexMatchReplace != nil {
+			routeAction.PathRewriteSpecifier = &routev3.RedirectAction_RegexRewrite{
+				RegexRewrite: &matcherv3.RegexMatchAndSubstitute{
+					Pattern: &matcherv3.RegexMatcher{
+						Regex: redirection.Path.RegexMatchReplace.Pattern,
+					},
+					Substitution: redirection.Path.RegexMatchReplace.Substitution,
+				},
+			}
 		}
 	}
 	if redirection.Hostname != nil {
@@ -239,10 +250,18 @@ func buildXdsRedirectAction(redirection *ir.Redirect) *routev3.RedirectAction {
 		routeAction.PortRedirect = *redirection.Port
 	}
 	if redirection.StatusCode != nil {
-		if *redirection.StatusCode == 302 {
+		switch *redirection.StatusCode {
+		case 302:
 			routeAction.ResponseCode = routev3.RedirectAction_FOUND
+		case 303:
+			routeAction.ResponseCode = routev3.RedirectAction_SEE_OTHER
+		case 307:
+			routeAction.ResponseCode = routev3.RedirectAction_TEMPORARY_REDIRECT
+		case 308:
+			routeAction.ResponseCode = routev3.RedirectAction_PERMANENT_REDIRECT
 		} // no need to check for 301 since Envoy will use 301 as the default if the field is not configured
 	}
+	routeAction.StripQuery = redirection.StripQuery
 
 	return routeAction
 }
@@ -264,14 +283,34 @@ func buildXdsURLRewriteAction(destName string, urlRewrite *ir.URLRewrite) *route
 			}
 		} else if urlRewrite.Path.PrefixMatchReplace != nil {
 			routeAction.PrefixRewrite = *urlRewrite.Path.PrefixMatchReplace
+		} else if urlRewrite.Path.RegexMatchReplace != nil {
+			routeAction.RegexRewrite = &matcherv3.RegexMatchAndSubstitute{
+				Pattern: &matcherv3.RegexMatcher{
+					Regex: urlRewrite.Path.RegexMatchReplace.Pattern,
+				},
+				Substitution: urlRewrite.Path.RegexMatchReplace.Substitution,
+			}
 		}
 	}
 
-	if urlRewrite.Hostname != nil {
+	switch {
+	case urlRewrite.Hostname != nil:
 		routeAction.HostRewriteSpecifier = &routev3.RouteAction_HostRewriteLiteral{
 			HostRewriteLiteral: *urlRewrite.Hostname,
 		}
 
+		routeAction.AppendXForwardedHost = true
+	case urlRewrite.HostnameFromHeader != nil:
+		routeAction.HostRewriteSpecifier = &routev3.RouteAction_HostRewriteHeader{
+			HostRewriteHeader: *urlRewrite.HostnameFromHeader,
+		}
+
+		routeAction.AppendXForwardedHost = true
+	case urlRewrite.AutoHostname:
+		routeAction.HostRewriteSpecifier = &routev3.RouteAction_AutoHostRewrite{
+			AutoHostRewrite: wrapperspb.Bool(true),
+		}
+
 		routeAction.AppendXForwardedHost = true
 	}
 
@@ -292,11 +331,23 @@ func buildXdsDirectResponseAction(res *ir.DirectResponse) *routev3.DirectRespons
 	return routeAction
 }
 
-func buildXdsRequestMirrorPolicies(destName string) []*routev3.RouteAction_RequestMirrorPolicy {
-	mirrorPolicies := []*routev3.RouteAction_RequestMirrorPolicy{
-		{
-			Cluster: destName,
-		},
+func buildXdsRequestMirrorPolicies(mirrors []*ir.MirrorPolicy) []*routev3.RouteAction_RequestMirrorPolicy {
+	mirrorPolicies := make([]*routev3.RouteAction_RequestMirrorPolicy, 0, len(mirrors))
+
+	for _, mirror := range mirrors {
+		mirrorPolicy := &routev3.RouteAction_RequestMirrorPolicy{
+			Cluster: mirror.Destination.Name,
+		}
+		if mirror.Percentage != nil {
+			mirrorPolicy.RuntimeFraction = &corev3.RuntimeFractionalPercent{
+				DefaultValue: &xdstype.FractionalPercent{
+					// Use a million as denominator to keep the decimals of the percentage.
+					Numerator:   uint32(math.Round(float64(*mirror.Percentage) * 10000)),
+					Denominator: xdstype.FractionalPercent_MILLION,
+				},
+			}
+		}
+		mirrorPolicies = append(mirrorPolicies, mirrorPolicy)
 	}
 
 	return mirrorPolicies
@@ -0,0 +1,157 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"errors"
+	"strings"
+
+	listenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	ratelimitv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/ratelimit/v3"
+	localratelimitv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/local_ratelimit/v3"
+	ratelimitfilterv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/ratelimit/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	rlsconfv3 "github.com/envoyproxy/go-control-plane/ratelimit/config/ratelimit/v3"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/xds/types"
+)
+
+const (
+	tcpLocalRateLimitFilter           = "envoy.filters.network.local_ratelimit"
+	tcpRateLimitFilterStatPrefix      = "tcp_rate_limiter"
+	tcpLocalRateLimitFilterStatPrefix = "tcp_local_rate_limiter"
+	// The descriptor entry of the client IP address of the connection, whose
+	// value is formatted by the network rate limit filter.
+	tcpRateLimitRemoteAddressKey   = "remote_address"
+	tcpRateLimitRemoteAddressValue = "%DOWNSTREAM_REMOTE_ADDRESS_WITHOUT_PORT%"
+)
+
+// patchTCPFilterChainWithRateLimit adds the network rate limit filters limiting
+// the new connections of the TCP listener to its filter chain, before the tcp
+// proxy. The global rate limits also add the cluster of the rate limit service.
+func (t *Translator) patchTCPFilterChainWithRateLimit(tCtx *types.ResourceVersionTable, filterChain *listenerv3.FilterChain,
+	irListener *ir.TCPListener) error {
+	if filterChain == nil {
+		return errors.New("filter chain is nil")
+	}
+	if irListener.ProxySettings == nil || irListener.ProxySettings.RateLimit == nil {
+		return nil
+	}
+	rateLimit := irListener.ProxySettings.RateLimit
+
+	var filters []*listenerv3.Filter
+	if rateLimit.Local != nil {
+		tokenBucket, err := buildLocalRateLimitTokenBucket(&ir.RateLimitRule{Limit: rateLimit.Local})
+		if err != nil {
+			return err
+		}
+		localRateLimitAny, err := anypb.New(&localratelimitv3.LocalRateLimit{
+			StatPrefix:  tcpLocalRateLimitFilterStatPrefix,
+			TokenBucket: tokenBucket,
+		})
+		if err != nil {
+			return err
+		}
+		filters = append(filters, &listenerv3.Filter{
+			Name: tcpLocalRateLimitFilter,
+			ConfigType: &listenerv3.Filter_TypedConfig{
+				TypedConfig: localRateLimitAny,
+			},
+		})
+	}
+
+	// Global rate limits are skipped if the rate limit service is disabled.
+	if len(rateLimit.Global) > 0 && t.GlobalRateLimit != nil {
+		rateLimitProto := &ratelimitfilterv3.RateLimit{
+			StatPrefix:       tcpRateLimitFilterStatPrefix,
+			Domain:           irListener.Name,
+			Descriptors:      buildTCPRateLimitDescriptors(irListener.Name, rateLimit.Global),
+			RateLimitService: buildRateLimitServiceConfig(),
+			FailureModeDeny:  t.GlobalRateLimit.FailClosed,
+		}
+		if t.GlobalRateLimit.Timeout > 0 {
+			rateLimitProto.Timeout = durationpb.New(t.GlobalRateLimit.Timeout)
+		}
+		rateLimitAny, err := anypb.New(rateLimitProto)
+		if err != nil {
+			return err
+		}
+		filters = append(filters, &listenerv3.Filter{
+			Name: wellknown.RateLimit,
+			ConfigType: &listenerv3.Filter_TypedConfig{
+				TypedConfig: rateLimitAny,
+			},
+		})
+
+		if err := t.addRateLimitServiceCluster(tCtx); err != nil {
+			return err
+		}
+	}
+
+	filterChain.Filters = append(filters, filterChain.Filters...)
+	return nil
+}
+
+// buildTCPRateLimitDescriptors returns the descriptors sent to the rate limit
+// service for each new connection, one per rule. The distinct rules add the
+// client IP address of the connection to the descriptor of the rule.
+func buildTCPRateLimitDescriptors(descriptorPrefix string, rules []*ir.TCPRateLimitRule) []*ratelimitv3.RateLimitDescriptor {
+	descriptors := make([]*ratelimitv3.RateLimitDescriptor, 0, len(rules))
+	for rIdx, rule := range rules {
+		entries := []*ratelimitv3.RateLimitDescriptor_Entry{
+			{
+				Key:   getRateLimitDescriptorKey(descriptorPrefix, rIdx, -1),
+				Value: getRateLimitDescriptorValue(descriptorPrefix, rIdx, -1),
+			},
+		}
+		if rule.Distinct {
+			entries = append(entries, &ratelimitv3.RateLimitDescriptor_Entry{
+				Key:   tcpRateLimitRemoteAddressKey,
+				Value: tcpRateLimitRemoteAddressValue,
+			})
+		}
+		descriptors = append(descriptors, &ratelimitv3.RateLimitDescriptor{Entries: entries})
+	}
+	return descriptors
+}
+
+// BuildTCPRateLimitServiceConfig builds the rate limit service configuration of
+// the global rate limits of the new connections of a TCP listener.
+func BuildTCPRateLimitServiceConfig(irListener *ir.TCPListener) *rlsconfv3.RateLimitConfig {
+	if irListener.ProxySettings == nil || irListener.ProxySettings.RateLimit == nil ||
+		len(irListener.ProxySettings.RateLimit.Global) == 0 {
+		return nil
+	}
+
+	rules := irListener.ProxySettings.RateLimit.Global
+	pbDescriptors := make([]*rlsconfv3.RateLimitDescriptor, 0, len(rules))
+	for rIdx, rule := range rules {
+		pbDesc := &rlsconfv3.RateLimitDescriptor{
+			Key:   getRateLimitDescriptorKey(irListener.Name, rIdx, -1),
+			Value: getRateLimitDescriptorValue(irListener.Name, rIdx, -1),
+		}
+		limitDesc := pbDesc
+		if rule.Distinct {
+			limitDesc = &rlsconfv3.RateLimitDescriptor{
+				Key: tcpRateLimitRemoteAddressKey,
+			}
+			pbDesc.Descriptors = []*rlsconfv3.RateLimitDescriptor{limitDesc}
+		}
+		limitDesc.RateLimit = &rlsconfv3.RateLimitPolicy{
+			RequestsPerUnit: rateLimitRequestsPerUnit(&ir.RateLimitRule{Limit: rule.Limit}),
+			Unit:            rlsconfv3.RateLimitUnit(rlsconfv3.RateLimitUnit_value[strings.ToUpper(string(rule.Limit.Unit))]),
+		}
+		pbDescriptors = append(pbDescriptors, pbDesc)
+	}
+
+	return &rlsconfv3.RateLimitConfig{
+		Domain:      irListener.Name,
+		Descriptors: pbDescriptors,
+	}
+}
@@ -0,0 +1,26 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	resourcev3 "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/xds/bootstrap"
+	"github.com/envoyproxy/gateway/internal/xds/types"
+)
+
+// processProxyTopology adds the endpoints of the local cluster of Envoy, i.e. the Envoy
+// proxies of the gateway, which are compared with the zones of the endpoints of the
+// other clusters for zone aware routing.
+func processProxyTopology(tCtx *types.ResourceVersionTable, topology *ir.ProxyTopology) error {
+	if topology == nil {
+		return nil
+	}
+
+	return tCtx.AddXdsResource(resourcev3.EndpointType,
+		buildXdsClusterLoadAssignment(bootstrap.EnvoyLocalClusterName, topology.Endpoints))
+}
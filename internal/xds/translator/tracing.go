@@ -13,7 +13,10 @@ import (
 	hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	tracingtype "github.com/envoyproxy/go-control-plane/envoy/type/tracing/v3"
 	xdstype "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
 	"github.com/envoyproxy/gateway/internal/ir"
@@ -21,26 +24,21 @@ import (
 	"github.com/envoyproxy/gateway/internal/xds/types"
 )
 
+const (
+	// defaultZipkinCollectorEndpoint is the API endpoint of the Zipkin collector, if unspecified.
+	defaultZipkinCollectorEndpoint = "/api/v2/spans"
+	// defaultOpenTelemetryCollectorPort is the OTLP/gRPC port of the OpenTelemetry collector, if unspecified.
+	defaultOpenTelemetryCollectorPort = 4317
+)
+
 func buildHCMTracing(tracing *ir.Tracing) (*hcm.HttpConnectionManager_Tracing, error) {
 	if tracing == nil {
 		return nil, nil
 	}
 
-	oc := &tracecfg.OpenTelemetryConfig{
-		GrpcService: &corev3.GrpcService{
-			TargetSpecifier: &corev3.GrpcService_EnvoyGrpc_{
-				EnvoyGrpc: &corev3.GrpcService_EnvoyGrpc{
-					ClusterName: buildClusterName("tracing", tracing.Provider.Host, uint32(tracing.Provider.Port)),
-					Authority:   tracing.Provider.Host,
-				},
-			},
-		},
-		ServiceName: tracing.ServiceName,
-	}
-
-	ocAny, err := protocov.ToAnyWithError(oc)
+	provider, err := buildTracingProvider(tracing)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to marshal OpenTelemetryConfig")
+		return nil, err
 	}
 
 	tags := []*tracingtype.CustomTag{}
@@ -105,29 +103,115 @@ func buildHCMTracing(tracing *ir.Tracing) (*hcm.HttpConnectionManager_Tracing, e
 		RandomSampling: &xdstype.Percent{
 			Value: float64(*tracing.SamplingRate),
 		},
-		Provider: &tracecfg.Tracing_Http{
-			Name: "envoy.tracers.opentelemetry",
-			ConfigType: &tracecfg.Tracing_Http_TypedConfig{
-				TypedConfig: ocAny,
+		Provider:   provider,
+		CustomTags: tags,
+	}, nil
+}
+
+// buildTracingProvider returns the tracer of the provider, which sends the spans
+// to the tracing cluster. The propagation format of the trace context is the one
+// of the tracer.
+func buildTracingProvider(tracing *ir.Tracing) (*tracecfg.Tracing_Http, error) {
+	clusterName := buildClusterName("tracing", tracing.Provider.Host, tracingProviderPort(tracing))
+
+	var (
+		name      string
+		tracerAny *anypb.Any
+		err       error
+	)
+	switch tracing.Provider.Type {
+	case egcfgv1a1.TracingProviderTypeZipkin:
+		zc := &tracecfg.ZipkinConfig{
+			CollectorCluster:         clusterName,
+			CollectorEndpoint:        defaultZipkinCollectorEndpoint,
+			CollectorEndpointVersion: tracecfg.ZipkinConfig_HTTP_JSON,
+			CollectorHostname:        tracing.Provider.Host,
+		}
+		if zipkin := tracing.Provider.Zipkin; zipkin != nil {
+			if zipkin.CollectorEndpoint != nil {
+				zc.CollectorEndpoint = *zipkin.CollectorEndpoint
+			}
+			if zipkin.Enable128BitTraceID != nil {
+				zc.TraceId_128Bit = *zipkin.Enable128BitTraceID
+			}
+			if zipkin.DisableSharedSpanContext != nil {
+				zc.SharedSpanContext = wrapperspb.Bool(!*zipkin.DisableSharedSpanContext)
+			}
+		}
+
+		name = wellknown.Zipkin
+		if tracerAny, err = protocov.ToAnyWithError(zc); err != nil {
+			return nil, errors.Wrap(err, "failed to marshal ZipkinConfig")
+		}
+	case egcfgv1a1.TracingProviderTypeDatadog:
+		dc := &tracecfg.DatadogConfig{
+			CollectorCluster:  clusterName,
+			ServiceName:       tracing.ServiceName,
+			CollectorHostname: tracing.Provider.Host,
+		}
+
+		name = wellknown.Datadog
+		if tracerAny, err = protocov.ToAnyWithError(dc); err != nil {
+			return nil, errors.Wrap(err, "failed to marshal DatadogConfig")
+		}
+	default:
+		oc := &tracecfg.OpenTelemetryConfig{
+			GrpcService: &corev3.GrpcService{
+				TargetSpecifier: &corev3.GrpcService_EnvoyGrpc_{
+					EnvoyGrpc: &corev3.GrpcService_EnvoyGrpc{
+						ClusterName: clusterName,
+						Authority:   tracing.Provider.Host,
+					},
+				},
 			},
+			ServiceName: tracing.ServiceName,
+		}
+
+		name = "envoy.tracers.opentelemetry"
+		if tracerAny, err = protocov.ToAnyWithError(oc); err != nil {
+			return nil, errors.Wrap(err, "failed to marshal OpenTelemetryConfig")
+		}
+	}
+
+	return &tracecfg.Tracing_Http{
+		Name: name,
+		ConfigType: &tracecfg.Tracing_Http_TypedConfig{
+			TypedConfig: tracerAny,
 		},
-		CustomTags: tags,
 	}, nil
 }
 
+// tracingProviderPort returns the port the collector of the tracing provider
+// receives the spans on. The ports of the Zipkin collector and the Datadog
+// agent are defaulted by the gatewayapi translator.
+func tracingProviderPort(tracing *ir.Tracing) uint32 {
+	if tracing.Provider.Port == 0 && tracing.Provider.Type != egcfgv1a1.TracingProviderTypeZipkin &&
+		tracing.Provider.Type != egcfgv1a1.TracingProviderTypeDatadog {
+		return defaultOpenTelemetryCollectorPort
+	}
+	return uint32(tracing.Provider.Port)
+}
+
 func processClusterForTracing(tCtx *types.ResourceVersionTable, tracing *ir.Tracing) error {
 	if tracing == nil {
 		return nil
 	}
 
-	clusterName := buildClusterName("tracing", tracing.Provider.Host, uint32(tracing.Provider.Port))
+	clusterName := buildClusterName("tracing", tracing.Provider.Host, tracingProviderPort(tracing))
+
+	// The OpenTelemetry collector receives the spans over gRPC, the Zipkin
+	// collector and the Datadog agent over HTTP/1.1.
+	protocol := HTTP2
+	if tracing.Provider.Type == egcfgv1a1.TracingProviderTypeZipkin || tracing.Provider.Type == egcfgv1a1.TracingProviderTypeDatadog {
+		protocol = HTTP
+	}
 
-	endpoints := []*ir.DestinationEndpoint{ir.NewDestEndpoint(tracing.Provider.Host, uint32(tracing.Provider.Port))}
+	endpoints := []*ir.DestinationEndpoint{ir.NewDestEndpoint(tracing.Provider.Host, tracingProviderPort(tracing))}
 	if err := addXdsCluster(tCtx, addXdsClusterArgs{
 		name:         clusterName,
 		endpoints:    endpoints,
 		tSocket:      nil,
-		protocol:     HTTP2,
+		protocol:     protocol,
 		endpointType: DefaultEndpointType,
 	}); err != nil && !errors.Is(err, ErrXdsClusterExists) {
 		return err
@@ -64,15 +64,19 @@ func (t *Translator) Translate(ir *ir.Xds) (*types.ResourceVersionTable, error)
 
 	tCtx := new(types.ResourceVersionTable)
 
-	if err := t.processHTTPListenerXdsTranslation(tCtx, ir.HTTP, ir.AccessLog, ir.Tracing, ir.Metrics); err != nil {
+	if err := t.processHTTPListenerXdsTranslation(tCtx, ir.HTTP, ir.Tracing, ir.Metrics); err != nil {
 		return nil, err
 	}
 
-	if err := processTCPListenerXdsTranslation(tCtx, ir.TCP, ir.AccessLog); err != nil {
+	if err := t.processTCPListenerXdsTranslation(tCtx, ir.TCP); err != nil {
 		return nil, err
 	}
 
-	if err := processUDPListenerXdsTranslation(tCtx, ir.UDP, ir.AccessLog); err != nil {
+	if err := processUDPListenerXdsTranslation(tCtx, ir.UDP); err != nil {
+		return nil, err
+	}
+
+	if err := processConnectionLimit(tCtx, ir.ConnectionLimit); err != nil {
 		return nil, err
 	}
 
@@ -80,13 +84,17 @@ func (t *Translator) Translate(ir *ir.Xds) (*types.ResourceVersionTable, error)
 		return nil, err
 	}
 
-	if err := processClusterForAccessLog(tCtx, ir.AccessLog); err != nil {
+	if err := processClusterForAccessLog(tCtx, ir); err != nil {
 		return nil, err
 	}
 	if err := processClusterForTracing(tCtx, ir.Tracing); err != nil {
 		return nil, err
 	}
 
+	if err := processProxyTopology(tCtx, ir.ProxyTopology); err != nil {
+		return nil, err
+	}
+
 	// Check if an extension want to inject any clusters/secrets
 	// If no extension exists (or it doesn't subscribe to this hook) then this is a quick no-op
 	if err := processExtensionPostTranslationHook(tCtx, t.ExtensionManager); err != nil {
@@ -97,7 +105,7 @@ func (t *Translator) Translate(ir *ir.Xds) (*types.ResourceVersionTable, error)
 }
 
 func (t *Translator) processHTTPListenerXdsTranslation(tCtx *types.ResourceVersionTable, httpListeners []*ir.HTTPListener,
-	accesslog *ir.AccessLog, tracing *ir.Tracing, metrics *ir.Metrics) error {
+	tracing *ir.Tracing, metrics *ir.Metrics) error {
 	for _, httpListener := range httpListeners {
 		addFilterChain := true
 		var xdsRouteCfg *routev3.RouteConfiguration
@@ -105,7 +113,7 @@ func (t *Translator) processHTTPListenerXdsTranslation(tCtx *types.ResourceVersi
 		// Search for an existing listener, if it does not exist, create one.
 		xdsListener := findXdsListenerByHostPort(tCtx, httpListener.Address, httpListener.Port, corev3.SocketAddress_TCP)
 		if xdsListener == nil {
-			xdsListener = buildXdsTCPListener(httpListener.Name, httpListener.Address, httpListener.Port, accesslog)
+			xdsListener = buildXdsTCPListener(httpListener.Name, httpListener.Address, httpListener.Port, httpListener.AccessLog)
 			if err := tCtx.AddXdsResource(resourcev3.ListenerType, xdsListener); err != nil {
 				return err
 			}
@@ -125,8 +133,15 @@ func (t *Translator) processHTTPListenerXdsTranslation(tCtx *types.ResourceVersi
 			}
 		}
 
+		// The protocol features enabled on the http connection manager of the routes.
+		servesGRPC, allowsWebSocket := listenerServesGRPC(httpListener), listenerAllowsWebSocket(httpListener)
 		if addFilterChain {
-			if err := t.addXdsHTTPFilterChain(xdsListener, httpListener, accesslog, tracing); err != nil {
+			if err := t.addXdsHTTPFilterChain(xdsListener, httpListener, tracing); err != nil {
+				return err
+			}
+		} else {
+			var err error
+			if servesGRPC, allowsWebSocket, err = t.patchXdsHTTPFilterChain(xdsListener, httpListener, xdsRouteCfg); err != nil {
 				return err
 			}
 		}
@@ -153,11 +168,6 @@ func (t *Translator) processHTTPListenerXdsTranslation(tCtx *types.ResourceVersi
 			}
 		}
 
-		protocol := DefaultProtocol
-		if httpListener.IsHTTP2 {
-			protocol = HTTP2
-		}
-
 		// store virtual hosts by domain
 		vHosts := map[string]*routev3.VirtualHost{}
 		// keep track of order by using a list as well as the map
@@ -202,6 +212,26 @@ func (t *Translator) processHTTPListenerXdsTranslation(tCtx *types.ResourceVersi
 			// 1:1 between IR HTTPRoute and xDS config.route.v3.Route
 			xdsRoute := buildXdsRoute(httpRoute)
 
+			// Disable the protocol features of the listener that the route doesn't use.
+			if err := patchRouteWithProtocolFeatures(xdsRoute, httpRoute, servesGRPC, allowsWebSocket); err != nil {
+				return err
+			}
+
+			// Add the grpc json transcoder per route config to the route, if needed.
+			if err := patchRouteWithGRPCJSONTranscoder(xdsRoute, httpRoute); err != nil {
+				return err
+			}
+
+			// Add the local rate limit per route config to the route, if needed.
+			if err := patchRouteWithLocalRateLimit(xdsRoute, httpRoute); err != nil {
+				return err
+			}
+
+			// Disable the access logs of the route, if needed.
+			if err := patchRouteWithAccessLogDisable(xdsRoute, httpRoute); err != nil {
+				return err
+			}
+
 			// Check if an extension want to modify the route we just generated
 			// If no extension exists (or it doesn't subscribe to this hook) then this is a quick no-op.
 			if err := processExtensionPostRouteHook(xdsRoute, vHost, httpRoute, t.ExtensionManager); err != nil {
@@ -215,20 +245,20 @@ func (t *Translator) processHTTPListenerXdsTranslation(tCtx *types.ResourceVersi
 					name:         httpRoute.Destination.Name,
 					endpoints:    httpRoute.Destination.Endpoints,
 					tSocket:      nil,
-					protocol:     protocol,
-					endpointType: Static,
+					protocol:     upstreamProtocol(httpRoute, httpRoute.Destination.Endpoints),
+					endpointType: buildEndpointType(httpRoute.Destination.Endpoints),
 				}); err != nil && !errors.Is(err, ErrXdsClusterExists) {
 					return err
 				}
 			}
 
-			if httpRoute.Mirror != nil {
+			for _, mirror := range httpRoute.Mirrors {
 				if err := addXdsCluster(tCtx, addXdsClusterArgs{
-					name:         httpRoute.Mirror.Name,
-					endpoints:    httpRoute.Mirror.Endpoints,
+					name:         mirror.Destination.Name,
+					endpoints:    mirror.Destination.Endpoints,
 					tSocket:      nil,
-					protocol:     protocol,
-					endpointType: Static,
+					protocol:     upstreamProtocol(httpRoute, mirror.Destination.Endpoints),
+					endpointType: buildEndpointType(mirror.Destination.Endpoints),
 				}); err != nil && !errors.Is(err, ErrXdsClusterExists) {
 					return err
 				}
@@ -265,17 +295,19 @@ func (t *Translator) processHTTPListenerXdsTranslation(tCtx *types.ResourceVersi
 	return nil
 }
 
-func processTCPListenerXdsTranslation(tCtx *types.ResourceVersionTable, tcpListeners []*ir.TCPListener, accesslog *ir.AccessLog) error {
+func (t *Translator) processTCPListenerXdsTranslation(tCtx *types.ResourceVersionTable, tcpListeners []*ir.TCPListener) error {
 	for _, tcpListener := range tcpListeners {
-		// 1:1 between IR TCPListener and xDS Cluster
-		if err := addXdsCluster(tCtx, addXdsClusterArgs{
-			name:         tcpListener.Destination.Name,
-			endpoints:    tcpListener.Destination.Endpoints,
-			tSocket:      nil,
-			protocol:     DefaultProtocol,
-			endpointType: Static,
-		}); err != nil && !errors.Is(err, ErrXdsClusterExists) {
-			return err
+		// 1:1 between IR TCPListener destinations and xDS Clusters
+		for _, destination := range tcpListenerDestinations(tcpListener) {
+			if err := addXdsCluster(tCtx, addXdsClusterArgs{
+				name:         destination.Name,
+				endpoints:    destination.Endpoints,
+				tSocket:      nil,
+				protocol:     DefaultProtocol,
+				endpointType: buildEndpointType(destination.Endpoints),
+			}); err != nil && !errors.Is(err, ErrXdsClusterExists) {
+				return err
+			}
 		}
 
 		if tcpListener.TLS != nil && tcpListener.TLS.Terminate != nil {
@@ -289,35 +321,42 @@ func processTCPListenerXdsTranslation(tCtx *types.ResourceVersionTable, tcpListe
 		// Search for an existing listener, if it does not exist, create one.
 		xdsListener := findXdsListenerByHostPort(tCtx, tcpListener.Address, tcpListener.Port, corev3.SocketAddress_TCP)
 		if xdsListener == nil {
-			xdsListener = buildXdsTCPListener(tcpListener.Name, tcpListener.Address, tcpListener.Port, accesslog)
+			xdsListener = buildXdsTCPListener(tcpListener.Name, tcpListener.Address, tcpListener.Port, tcpListener.AccessLog)
 			if err := tCtx.AddXdsResource(resourcev3.ListenerType, xdsListener); err != nil {
 				return err
 			}
 		}
 
-		if err := addXdsTCPFilterChain(xdsListener, tcpListener, tcpListener.Destination.Name, accesslog); err != nil {
+		if err := addXdsTCPFilterChain(xdsListener, tcpListener); err != nil {
+			return err
+		}
+
+		// The filter chain of the listener was appended last.
+		filterChain := xdsListener.FilterChains[len(xdsListener.FilterChains)-1]
+		if err := t.patchTCPFilterChainWithRateLimit(tCtx, filterChain, tcpListener); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func processUDPListenerXdsTranslation(tCtx *types.ResourceVersionTable, udpListeners []*ir.UDPListener, accesslog *ir.AccessLog) error {
+func processUDPListenerXdsTranslation(tCtx *types.ResourceVersionTable, udpListeners []*ir.UDPListener) error {
 	for _, udpListener := range udpListeners {
 		// 1:1 between IR UDPListener and xDS Cluster
+		destination := udpListenerDestination(udpListener)
 		if err := addXdsCluster(tCtx, addXdsClusterArgs{
-			name:         udpListener.Destination.Name,
-			endpoints:    udpListener.Destination.Endpoints,
+			name:         destination.Name,
+			endpoints:    destination.Endpoints,
 			tSocket:      nil,
 			protocol:     DefaultProtocol,
-			endpointType: Static,
+			endpointType: buildEndpointType(destination.Endpoints),
 		}); err != nil && !errors.Is(err, ErrXdsClusterExists) {
 			return err
 		}
 
 		// There won't be multiple UDP listeners on the same port since it's already been checked at the gateway api
 		// translator
-		xdsListener, err := buildXdsUDPListener(udpListener.Destination.Name, udpListener, accesslog)
+		xdsListener, err := buildXdsUDPListener(destination.Name, udpListener)
 		if err != nil {
 			return multierror.Append(err, errors.New("error building xds cluster"))
 		}
@@ -329,6 +368,35 @@ func processUDPListenerXdsTranslation(tCtx *types.ResourceVersionTable, udpListe
 
 }
 
+// tcpListenerDestinations returns the destinations of a TCP listener.
+func tcpListenerDestinations(tcpListener *ir.TCPListener) []*ir.RouteDestination {
+	if tcpListener.Destination != nil {
+		return []*ir.RouteDestination{tcpListener.Destination}
+	}
+	destinations := make([]*ir.RouteDestination, 0, len(tcpListener.WeightedDestinations))
+	for _, weighted := range tcpListener.WeightedDestinations {
+		destinations = append(destinations, weighted.Destination)
+	}
+	return destinations
+}
+
+// udpListenerDestination returns the destination of a UDP listener. The UDP proxy
+// can only route a session to a single cluster, so weighted destinations are merged
+// into a cluster holding the endpoints of all the destinations, whose weights are
+// normalized across the destinations.
+func udpListenerDestination(udpListener *ir.UDPListener) *ir.RouteDestination {
+	if udpListener.Destination != nil {
+		return udpListener.Destination
+	}
+	destination := &ir.RouteDestination{Name: udpListener.Name}
+	for _, weighted := range udpListener.WeightedDestinations {
+		for _, ep := range weighted.Destination.Endpoints {
+			destination.Endpoints = append(destination.Endpoints, ep.DeepCopy())
+		}
+	}
+	return destination
+}
+
 // findXdsListenerByHostPort finds a xds listener with the same address, port and protocol, and returns nil if there is no match.
 func findXdsListenerByHostPort(tCtx *types.ResourceVersionTable, address string, port uint32,
 	protocol corev3.SocketAddress_Protocol) *listenerv3.Listener {
@@ -419,6 +487,12 @@ func addXdsCluster(tCtx *types.ResourceVersionTable, args addXdsClusterArgs) err
 	}
 
 	xdsCluster := buildXdsCluster(args.name, args.tSocket, args.protocol, args.endpointType)
+	if endpointsHaveZones(args.endpoints) {
+		enableZoneAwareRouting(xdsCluster)
+	}
+	if endpointsHavePriorities(args.endpoints) {
+		enableFailover(xdsCluster)
+	}
 	xdsEndpoints := buildXdsClusterLoadAssignment(args.name, args.endpoints)
 	// Use EDS for static endpoints
 	if args.endpointType == Static {
@@ -451,6 +525,8 @@ const (
 	UDP
 	HTTP
 	HTTP2
+	// HTTP2WebSocket is HTTP2 with the WebSocket upgrades tunneled over the extended CONNECT method.
+	HTTP2WebSocket
 )
 
 const (
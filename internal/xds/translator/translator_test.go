@@ -59,9 +59,15 @@ func TestTranslateXds(t *testing.T) {
 		{
 			name: "http-route-redirect",
 		},
+		{
+			name: "http-route-redirect-regex",
+		},
 		{
 			name: "http-route-mirror",
 		},
+		{
+			name: "http-route-mirror-percentage",
+		},
 		{
 			name: "http-route-multiple-matches",
 		},
@@ -112,6 +118,22 @@ func TestTranslateXds(t *testing.T) {
 		{
 			name: "tcp-route-weighted-backend",
 		},
+		{
+			name: "tcp-route-weighted-clusters",
+		},
+		{
+			name: "tcp-route-proxy-settings",
+		},
+		{
+			name: "tcp-route-ratelimit",
+		},
+		{
+			name: "tls-route-ratelimit",
+		},
+		{
+			name:           "listener-connection-limit",
+			requireSecrets: true,
+		},
 		{
 			name:           "multiple-listeners-same-port",
 			requireSecrets: true,
@@ -119,12 +141,39 @@ func TestTranslateXds(t *testing.T) {
 		{
 			name: "udp-route",
 		},
+		{
+			name: "udp-route-weighted-backends",
+		},
+		{
+			name: "udp-route-proxy-settings",
+		},
 		{
 			name: "http2-route",
 		},
+		{
+			name: "http-and-grpc-routes",
+		},
+		{
+			name: "grpc-json-transcoder",
+		},
+		{
+			name: "http-route-backend-app-protocols",
+		},
+		{
+			name: "http-route-endpoint-zones",
+		},
+		{
+			name: "http-route-endpoint-priorities",
+		},
+		{
+			name: "http-route-hostname-endpoints",
+		},
 		{
 			name: "http-route-rewrite-url-prefix",
 		},
+		{
+			name: "http-route-rewrite-url-regex",
+		},
 		{
 			name: "http-route-rewrite-root-path-url-prefix",
 		},
@@ -134,6 +183,9 @@ func TestTranslateXds(t *testing.T) {
 		{
 			name: "http-route-rewrite-url-host",
 		},
+		{
+			name: "http-route-rewrite-url-host-dynamic",
+		},
 		{
 			name: "ratelimit",
 		},
@@ -144,6 +196,15 @@ func TestTranslateXds(t *testing.T) {
 		{
 			name: "ratelimit-sourceip",
 		},
+		{
+			name: "local-ratelimit",
+		},
+		{
+			name: "ratelimit-selectors",
+		},
+		{
+			name: "local-ratelimit-cost",
+		},
 		{
 			name: "authn-single-route-single-match",
 		},
@@ -159,12 +220,39 @@ func TestTranslateXds(t *testing.T) {
 		{
 			name: "accesslog",
 		},
+		{
+			name: "accesslog-filter",
+		},
+		{
+			name: "accesslog-als",
+		},
+		{
+			name: "accesslog-stream",
+		},
+		{
+			name: "accesslog-route-disable",
+		},
+		{
+			name: "accesslog-route-disable-multiple-listeners",
+		},
+		{
+			name: "multiple-listeners-same-port-features",
+		},
 		{
 			name: "tracing",
 		},
+		{
+			name: "tracing-zipkin",
+		},
+		{
+			name: "tracing-datadog",
+		},
 		{
 			name: "metrics-virtual-host",
 		},
+		{
+			name: "local-reply",
+		},
 		{
 			name:                      "jsonpatch",
 			requireEnvoyPatchPolicies: true,
@@ -318,6 +406,15 @@ func TestTranslateRateLimitConfig(t *testing.T) {
 		{
 			name: "masked-remote-address-match",
 		},
+		{
+			name: "query-param-and-jwt-claim-matches",
+		},
+		{
+			name: "shadow-mode",
+		},
+		{
+			name: "cost",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -333,6 +430,28 @@ func TestTranslateRateLimitConfig(t *testing.T) {
 	}
 }
 
+func TestTranslateTCPRateLimitConfig(t *testing.T) {
+	testCases := []struct {
+		name string
+	}{
+		{
+			name: "tcp-listener",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			in := requireXdsIRTCPListenerFromInputTestData(t, "ratelimit-config", tc.name+".yaml")
+			out := BuildTCPRateLimitServiceConfig(in)
+			if *overrideTestData {
+				require.NoError(t, file.Write(requireYamlRootToYAMLString(t, out), filepath.Join("testdata", "out", "ratelimit-config", tc.name+".yaml")))
+			}
+			require.Equal(t, requireTestDataOutFile(t, "ratelimit-config", tc.name+".yaml"), requireYamlRootToYAMLString(t, out))
+		})
+	}
+}
+
 func TestTranslateXdsWithExtension(t *testing.T) {
 	testCases := []struct {
 		name           string
@@ -459,6 +578,17 @@ func requireXdsIRListenerFromInputTestData(t *testing.T, name ...string) *ir.HTT
 	return listener
 }
 
+func requireXdsIRTCPListenerFromInputTestData(t *testing.T, name ...string) *ir.TCPListener {
+	t.Helper()
+	elems := append([]string{"testdata", "in"}, name...)
+	content, err := inFiles.ReadFile(filepath.Join(elems...))
+	require.NoError(t, err)
+	listener := &ir.TCPListener{}
+	err = yaml.Unmarshal(content, listener)
+	require.NoError(t, err)
+	return listener
+}
+
 func requireTestDataOutFile(t *testing.T, name ...string) string {
 	t.Helper()
 	elems := append([]string{"testdata", "out"}, name...)